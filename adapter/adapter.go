@@ -2,17 +2,13 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+// Code generated by dapgen; DO NOT EDIT.
+
 package adapter
 
 // BeforeExitCallback Dispose and allow exit to continue normally
 type BeforeExitCallback struct{}
 
-// Body Event-specific information.
-type Body struct {
-	Reason   string  `json:"reason,omitempty"`
-	ThreadId float64 `json:"threadId,omitempty"`
-}
-
 // Breakpoint
 type Breakpoint struct {
 	// If true breakpoint could be set (but not necessarily at the desired location).
@@ -22,35 +18,46 @@ type Breakpoint struct {
 // BreakpointEvent
 type BreakpointEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *BreakpointEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// BreakpointEventBody Event-specific information.
+type BreakpointEventBody struct {
+	Breakpoint *Breakpoint `json:"breakpoint,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+}
+
 // CapabilitiesEvent
 type CapabilitiesEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *CapabilitiesEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// CapabilitiesEventBody Event-specific information.
+type CapabilitiesEventBody struct {
+	Capabilities *DebugProtocolCapabilities `json:"capabilities,omitempty"`
+}
+
 // CompletionItem
 type CompletionItem struct {
 	// The label of this completion item. By default this is also the text that is inserted when selecting this completion.
@@ -58,29 +65,34 @@ type CompletionItem struct {
 
 	// This value determines how many characters are overwritten by the completion text.
 	// If missing the value 0 is assumed which results in the completion text being inserted.
-	Length float64 `json:"length,omitempty"`
+	Length int `json:"length,omitempty"`
 
 	// This value determines the location (in the CompletionsRequest's 'text' attribute) where the completion text is added.
 	// If missing the text is added at the location specified by the CompletionsRequest's 'column' attribute.
-	Start float64 `json:"start,omitempty"`
+	Start int `json:"start,omitempty"`
 }
 
 // ContinuedEvent
 type ContinuedEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ContinuedEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// ContinuedEventBody Event-specific information.
+type ContinuedEventBody struct {
+	ThreadId int `json:"threadId,omitempty"`
+}
+
 // DebugProtocolCapabilities Information about the capabilities of a debug adapter.
 type DebugProtocolCapabilities struct {
 	// The set of additional module information exposed by the debug adapter.
@@ -96,7 +108,7 @@ type DebugProtocolCapabilities struct {
 	SupportTerminateDebuggee bool `json:"supportTerminateDebuggee,omitempty"`
 
 	// Checksum algorithms supported by the debug adapter.
-	SupportedChecksumAlgorithms []string `json:"supportedChecksumAlgorithms,omitempty"`
+	SupportedChecksumAlgorithms []DebugProtocolCapabilitiesSupportedChecksumAlgorithm `json:"supportedChecksumAlgorithms,omitempty"`
 
 	// The debug adapter supports the 'breakpointLocations' request.
 	SupportsBreakpointLocationsRequest bool `json:"supportsBreakpointLocationsRequest,omitempty"`
@@ -180,6 +192,16 @@ type DebugProtocolCapabilities struct {
 	SupportsValueFormattingOptions bool `json:"supportsValueFormattingOptions,omitempty"`
 }
 
+// DebugProtocolCapabilitiesSupportedChecksumAlgorithm
+type DebugProtocolCapabilitiesSupportedChecksumAlgorithm string
+
+const (
+	DebugProtocolCapabilitiesSupportedChecksumAlgorithmMD5       DebugProtocolCapabilitiesSupportedChecksumAlgorithm = "MD5"
+	DebugProtocolCapabilitiesSupportedChecksumAlgorithmSHA1      DebugProtocolCapabilitiesSupportedChecksumAlgorithm = "SHA1"
+	DebugProtocolCapabilitiesSupportedChecksumAlgorithmSHA256    DebugProtocolCapabilitiesSupportedChecksumAlgorithm = "SHA256"
+	DebugProtocolCapabilitiesSupportedChecksumAlgorithmTimestamp DebugProtocolCapabilitiesSupportedChecksumAlgorithm = "timestamp"
+)
+
 // DebugProtocolColumnDescriptor A ColumnDescriptor specifies what module attribute to show in a column of the ModulesView, how to format it, and what the column's label should be.
 // It is only used if the underlying UI actually supports this level of customization.
 type DebugProtocolColumnDescriptor struct {
@@ -193,12 +215,22 @@ type DebugProtocolColumnDescriptor struct {
 	Label string `json:"label,omitempty"`
 
 	// Datatype of values in this column.  Defaults to 'string' if not specified.
-	Type string `json:"type,omitempty"`
+	Type DebugProtocolColumnDescriptorType `json:"type,omitempty"`
 
 	// Width of this column in characters (hint only).
-	Width float64 `json:"width,omitempty"`
+	Width int `json:"width,omitempty"`
 }
 
+// DebugProtocolColumnDescriptorType Datatype of values in this column.  Defaults to 'string' if not specified.
+type DebugProtocolColumnDescriptorType string
+
+const (
+	DebugProtocolColumnDescriptorTypeBoolean          DebugProtocolColumnDescriptorType = "boolean"
+	DebugProtocolColumnDescriptorTypeNumber           DebugProtocolColumnDescriptorType = "number"
+	DebugProtocolColumnDescriptorTypeString           DebugProtocolColumnDescriptorType = "string"
+	DebugProtocolColumnDescriptorTypeUnixTimestampUTC DebugProtocolColumnDescriptorType = "unixTimestampUTC"
+)
+
 // DebugProtocolExceptionBreakpointsFilter An ExceptionBreakpointsFilter is shown in the UI as an option for configuring how exceptions are dealt with.
 type DebugProtocolExceptionBreakpointsFilter struct {
 	// Initial value of the filter. If not specified a value 'false' is assumed.
@@ -219,7 +251,7 @@ type DebugSession struct {
 	ClientColumnsStartAt1   bool                        `json:"_clientColumnsStartAt1,omitempty"`
 	ClientLinesStartAt1     bool                        `json:"_clientLinesStartAt1,omitempty"`
 	ClientPathsAreURIs      bool                        `json:"_clientPathsAreURIs,omitempty"`
-	ContentLength           float64                     `json:"_contentLength,omitempty"`
+	ContentLength           int                         `json:"_contentLength,omitempty"`
 	DebuggerColumnsStartAt1 bool                        `json:"_debuggerColumnsStartAt1,omitempty"`
 	DebuggerLinesStartAt1   bool                        `json:"_debuggerLinesStartAt1,omitempty"`
 	DebuggerPathsAreURIs    bool                        `json:"_debuggerPathsAreURIs,omitempty"`
@@ -234,7 +266,7 @@ type DebugSession struct {
 	// Valid string encodings: 'ascii'|'utf8'|'utf16le'|'ucs2'(alias of 'utf16le')|'base64'|'binary'(deprecated)|'hex'
 	RawData        []float64                    `json:"_rawData,omitempty"`
 	SendMessage    *EmitterDebugProtocolMessage `json:"sendMessage,omitempty"`
-	Sequence       float64                      `json:"_sequence,omitempty"`
+	Sequence       int                          `json:"_sequence,omitempty"`
 	WritableStream *NodeJSWritableStream        `json:"_writableStream,omitempty"`
 }
 
@@ -257,7 +289,7 @@ type EmitterDebugProtocolMessage struct {
 
 // EmitterError
 type EmitterError struct {
-	Event    *Event0Error `json:"event,omitempty"`
+	Event    *Event0Error `json:"_event,omitempty"`
 	Listener *Listener    `json:"_listener,omitempty"`
 	This     interface{}  `json:"_this,omitempty"`
 }
@@ -268,7 +300,7 @@ type Event struct {
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
@@ -290,8 +322,8 @@ type Event0T struct{}
 // Handles
 type Handles struct {
 	HandleMap   interface{} `json:"_handleMap,omitempty"`
-	NextHandle  float64     `json:"_nextHandle,omitempty"`
-	STARTHANDLE float64     `json:"START_HANDLE,omitempty"`
+	NextHandle  int         `json:"_nextHandle,omitempty"`
+	STARTHANDLE int         `json:"START_HANDLE,omitempty"`
 }
 
 // IDisposable
@@ -322,7 +354,7 @@ type InitializedEvent struct {
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
@@ -356,38 +388,60 @@ type Listener struct{}
 // LoadedSourceEvent
 type LoadedSourceEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *LoadedSourceEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// LoadedSourceEventBody Event-specific information.
+type LoadedSourceEventBody struct {
+	Reason LoadedSourceEventReason `json:"reason,omitempty"`
+	Source *Source                 `json:"source,omitempty"`
+}
+
+// LoadedSourceEventReason
+type LoadedSourceEventReason string
+
+const (
+	LoadedSourceEventReasonChanged LoadedSourceEventReason = "changed"
+	LoadedSourceEventReasonNew     LoadedSourceEventReason = "new"
+	LoadedSourceEventReasonRemoved LoadedSourceEventReason = "removed"
+)
+
 // LogCallback Log info that meets minLogLevel is sent to this callback.
 type LogCallback struct{}
 
 // LogOutputEvent
 type LogOutputEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *LogOutputEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// LogOutputEventBody Event-specific information.
+type LogOutputEventBody struct {
+	Category string      `json:"category,omitempty"`
+	Data     interface{} `json:"data,omitempty"`
+	Output   string      `json:"output,omitempty"`
+}
+
 // Logger
 type Logger struct {
 	CurrentLogger       *InternalLogger `json:"_currentLogger,omitempty"`
@@ -400,7 +454,7 @@ type LoggingDebugSession struct {
 	ClientColumnsStartAt1   bool                        `json:"_clientColumnsStartAt1,omitempty"`
 	ClientLinesStartAt1     bool                        `json:"_clientLinesStartAt1,omitempty"`
 	ClientPathsAreURIs      bool                        `json:"_clientPathsAreURIs,omitempty"`
-	ContentLength           float64                     `json:"_contentLength,omitempty"`
+	ContentLength           int                         `json:"_contentLength,omitempty"`
 	DebuggerColumnsStartAt1 bool                        `json:"_debuggerColumnsStartAt1,omitempty"`
 	DebuggerLinesStartAt1   bool                        `json:"_debuggerLinesStartAt1,omitempty"`
 	DebuggerPathsAreURIs    bool                        `json:"_debuggerPathsAreURIs,omitempty"`
@@ -416,14 +470,14 @@ type LoggingDebugSession struct {
 	// Valid string encodings: 'ascii'|'utf8'|'utf16le'|'ucs2'(alias of 'utf16le')|'base64'|'binary'(deprecated)|'hex'
 	RawData        []float64                    `json:"_rawData,omitempty"`
 	SendMessage    *EmitterDebugProtocolMessage `json:"sendMessage,omitempty"`
-	Sequence       float64                      `json:"_sequence,omitempty"`
+	Sequence       int                          `json:"_sequence,omitempty"`
 	WritableStream *NodeJSWritableStream        `json:"_writableStream,omitempty"`
 }
 
 // Message
 type Message struct {
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
@@ -442,19 +496,34 @@ type Module struct {
 // ModuleEvent
 type ModuleEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ModuleEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// ModuleEventBody Event-specific information.
+type ModuleEventBody struct {
+	Module *Module           `json:"module,omitempty"`
+	Reason ModuleEventReason `json:"reason,omitempty"`
+}
+
+// ModuleEventReason
+type ModuleEventReason string
+
+const (
+	ModuleEventReasonChanged ModuleEventReason = "changed"
+	ModuleEventReasonNew     ModuleEventReason = "new"
+	ModuleEventReasonRemoved ModuleEventReason = "removed"
+)
+
 // NodeJSWritableStream
 type NodeJSWritableStream struct {
 	Writable bool `json:"writable,omitempty"`
@@ -463,22 +532,29 @@ type NodeJSWritableStream struct {
 // OutputEvent
 type OutputEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *OutputEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// OutputEventBody Event-specific information.
+type OutputEventBody struct {
+	Category string      `json:"category,omitempty"`
+	Data     interface{} `json:"data,omitempty"`
+	Output   string      `json:"output,omitempty"`
+}
+
 // ProtocolServer
 type ProtocolServer struct {
-	ContentLength   float64                     `json:"_contentLength,omitempty"`
+	ContentLength   int                         `json:"_contentLength,omitempty"`
 	Error           *EmitterError               `json:"error,omitempty"`
 	OnError         *Event0Error                `json:"onError,omitempty"`
 	OnSendMessage   *Event0DebugProtocolMessage `json:"onSendMessage,omitempty"`
@@ -489,7 +565,7 @@ type ProtocolServer struct {
 	// Valid string encodings: 'ascii'|'utf8'|'utf16le'|'ucs2'(alias of 'utf16le')|'base64'|'binary'(deprecated)|'hex'
 	RawData        []float64                    `json:"_rawData,omitempty"`
 	SendMessage    *EmitterDebugProtocolMessage `json:"sendMessage,omitempty"`
-	Sequence       float64                      `json:"_sequence,omitempty"`
+	Sequence       int                          `json:"_sequence,omitempty"`
 	WritableStream *NodeJSWritableStream        `json:"_writableStream,omitempty"`
 }
 
@@ -499,10 +575,10 @@ type Response struct {
 	Command string `json:"command,omitempty"`
 
 	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	RequestSeq int `json:"request_seq,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Outcome of the request.
 	// If true, the request was successful and the 'body' attribute may contain the result of the request.
@@ -523,7 +599,7 @@ type Scope struct {
 	Name string `json:"name,omitempty"`
 
 	// The variables of this scope can be retrieved by passing the value of variablesReference to the VariablesRequest.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
 // Source
@@ -535,19 +611,19 @@ type Source struct {
 	Path string `json:"path,omitempty"`
 
 	// If sourceReference > 0 the contents of the source must be retrieved through the SourceRequest (even if a path is specified). A sourceReference is only valid for a session, so it must not be used to persist a source. The value should be less than or equal to 2147483647 (2^31 - 1).
-	SourceReference float64 `json:"sourceReference,omitempty"`
+	SourceReference int `json:"sourceReference,omitempty"`
 }
 
 // StackFrame
 type StackFrame struct {
 	// The column within the line. If source is null or doesn't exist, column is 0 and must be ignored.
-	Column float64 `json:"column,omitempty"`
+	Column int `json:"column,omitempty"`
 
 	// An identifier for the stack frame. It must be unique across all threads. This id can be used to retrieve the scopes of the frame with the 'scopesRequest' or to restart the execution of a stackframe.
-	Id float64 `json:"id,omitempty"`
+	Id int `json:"id,omitempty"`
 
 	// The line within the file of the frame. If source is null or doesn't exist, line is 0 and must be ignored.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line,omitempty"`
 
 	// The name of the stack frame, typically a method name.
 	Name string `json:"name,omitempty"`
@@ -559,26 +635,31 @@ type StackFrame struct {
 // StoppedEvent
 type StoppedEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *StoppedEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// StoppedEventBody Event-specific information.
+type StoppedEventBody struct {
+	Reason string `json:"reason,omitempty"`
+}
+
 // TerminatedEvent
 type TerminatedEvent struct {
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
@@ -588,7 +669,7 @@ type TerminatedEvent struct {
 // Thread
 type Thread struct {
 	// Unique identifier for the thread.
-	Id float64 `json:"id,omitempty"`
+	Id int `json:"id,omitempty"`
 
 	// A name of the thread.
 	Name string `json:"name,omitempty"`
@@ -597,19 +678,25 @@ type Thread struct {
 // ThreadEvent
 type ThreadEvent struct {
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ThreadEventBody `json:"body,omitempty"`
 
 	// Type of event.
 	Event string `json:"event,omitempty"`
 
 	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Seq int `json:"seq,omitempty"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
 	Type string `json:"type,omitempty"`
 }
 
+// ThreadEventBody Event-specific information.
+type ThreadEventBody struct {
+	Reason   string `json:"reason,omitempty"`
+	ThreadId int    `json:"threadId,omitempty"`
+}
+
 // VSCodeDebugAdapter A structurally equivalent copy of vscode.DebugAdapter
 type VSCodeDebugAdapter struct {
 	OnError       *Event0Error                `json:"onError,omitempty"`
@@ -625,7 +712,7 @@ type Variable struct {
 	Value string `json:"value,omitempty"`
 
 	// If variablesReference is > 0, the variable is structured and its children can be retrieved by passing variablesReference to the VariablesRequest.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
 // WriteStream
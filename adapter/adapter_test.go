@@ -0,0 +1,55 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLargeReferenceRoundTrip(t *testing.T) {
+	for _, v := range []int{1<<31 - 2, 1<<31 - 1, 1 << 31, 1<<53 - 1, 1 << 53} {
+		in := &StackFrame{
+			Id:     v,
+			Line:   v,
+			Source: &Source{SourceReference: v},
+		}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out StackFrame
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Id != v || out.Line != v || out.Source == nil || out.Source.SourceReference != v {
+			t.Errorf("got %+v from %s, want id, line and sourceReference %d", out, data, v)
+		}
+
+		variable := &Variable{VariablesReference: v}
+		if data, err = json.Marshal(variable); err != nil {
+			t.Fatal(err)
+		}
+		var gotVariable Variable
+		if err := json.Unmarshal(data, &gotVariable); err != nil {
+			t.Fatal(err)
+		}
+		if gotVariable.VariablesReference != v {
+			t.Errorf("variablesReference: got %d from %s, want %d", gotVariable.VariablesReference, data, v)
+		}
+
+		thread := &ThreadEventBody{ThreadId: v}
+		if data, err = json.Marshal(thread); err != nil {
+			t.Fatal(err)
+		}
+		var gotThread ThreadEventBody
+		if err := json.Unmarshal(data, &gotThread); err != nil {
+			t.Fatal(err)
+		}
+		if gotThread.ThreadId != v {
+			t.Errorf("threadId: got %d from %s, want %d", gotThread.ThreadId, data, v)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// cancel cancels the request and the progress specified by the arguments of req,
+// then calls Handler.Cancel without waiting for the requests dispatched before.
+//
+// The cancellation is done by the session, so req is replied with a successful response
+// even if the Handler does not implement Cancel.
+func (s *Session) cancel(ctx context.Context, req *protocol.CancelRequest) {
+	if args := req.Arguments; args != nil {
+		s.cancelMu.Lock()
+		if cancel, ok := s.cancels[args.RequestId]; ok && args.RequestId != 0 {
+			cancel()
+		}
+		if cancel, ok := s.progresses[args.ProgressId]; ok && args.ProgressId != "" {
+			cancel()
+		}
+		s.cancelMu.Unlock()
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		resp := newResponse(req)
+		m, err := handlers[req.Command](ctx, s.handler, req, resp)
+		if errors.Is(err, ErrNotImplemented) {
+			m, err = &protocol.CancelResponse{Response: resp}, nil
+		}
+		s.reply(resp, m, err)
+	}()
+}
+
+// StartProgress sends the 'progressStart' event with body and returns a context derived from ctx,
+// which is cancelled when the client cancels the progress by its ID.
+// EndProgress must be called when the operation reporting the progress ends.
+//
+// The ID of the progress must be unique within the session.
+func (s *Session) StartProgress(ctx context.Context, body *protocol.ProgressStartEventBody) (context.Context, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancelMu.Lock()
+	if _, ok := s.progresses[body.ProgressId]; ok {
+		s.cancelMu.Unlock()
+		cancel()
+		return nil, fmt.Errorf("adapter: progress %q already started", body.ProgressId)
+	}
+	s.progresses[body.ProgressId] = cancel
+	s.cancelMu.Unlock()
+
+	if err := s.SendEvent(&protocol.ProgressStartEvent{Body: body}); err != nil {
+		s.endProgress(body.ProgressId)
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// UpdateProgress sends the 'progressUpdate' event with body.
+func (s *Session) UpdateProgress(body *protocol.ProgressUpdateEventBody) error {
+	return s.SendEvent(&protocol.ProgressUpdateEvent{Body: body})
+}
+
+// EndProgress sends the 'progressEnd' event with body and releases the context returned by StartProgress.
+func (s *Session) EndProgress(body *protocol.ProgressEndEventBody) error {
+	s.endProgress(body.ProgressId)
+	return s.SendEvent(&protocol.ProgressEndEvent{Body: body})
+}
+
+func (s *Session) endProgress(id string) {
+	s.cancelMu.Lock()
+	cancel, ok := s.progresses[id]
+	delete(s.progresses, id)
+	s.cancelMu.Unlock()
+	if ok {
+		cancel()
+	}
+}
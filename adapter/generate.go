@@ -0,0 +1,8 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+//go:generate go run ../cmd/dapgen -i ../api/adapter.json -p adapter -int threadId,seq,length,start,width,_contentLength,_sequence,_nextHandle,START_HANDLE,request_seq,variablesReference,sourceReference,column,id,line -rename InternalLoggerBeforeExitCallback=BeforeExitCallback,InternalLoggerLogCallback=LogCallback,EmitterListener=Listener,EmitterDebugProtocolMessageListener=Listener,EmitterErrorListener=Listener -o adapter.go
+//go:generate go run ../cmd/dapgen -i ../api/debugAdapterProtocol.json -p adapter -rename Message=ErrorMessage -handler -o handler.go
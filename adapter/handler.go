@@ -0,0 +1,801 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by dapgen; DO NOT EDIT.
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// Handler handles the requests sent by the client to the debug adapter.
+//
+// Each method handles the request of the same name and returns the body of its response.
+// The arguments of the requests whose arguments are optional may be nil.
+// The requests missing their required arguments are replied with an error response without calling the Handler.
+// An error returned by a method is sent to the client as an error response.
+//
+// Embed UnimplementedHandler in the implementations to reply with an error response
+// to the requests they do not support.
+type Handler interface {
+	// Attach handles the 'attach' request, see protocol.AttachRequest.
+	Attach(ctx context.Context, args *protocol.AttachRequestArguments) error
+
+	// BreakpointLocations handles the 'breakpointLocations' request, see protocol.BreakpointLocationsRequest.
+	BreakpointLocations(ctx context.Context, args *protocol.BreakpointLocationsArguments) (*protocol.BreakpointLocationsResponseBody, error)
+
+	// Cancel handles the 'cancel' request, see protocol.CancelRequest.
+	Cancel(ctx context.Context, args *protocol.CancelArguments) error
+
+	// Completions handles the 'completions' request, see protocol.CompletionsRequest.
+	Completions(ctx context.Context, args *protocol.CompletionsArguments) (*protocol.CompletionsResponseBody, error)
+
+	// ConfigurationDone handles the 'configurationDone' request, see protocol.ConfigurationDoneRequest.
+	ConfigurationDone(ctx context.Context, args *protocol.ConfigurationDoneArguments) error
+
+	// Continue handles the 'continue' request, see protocol.ContinueRequest.
+	Continue(ctx context.Context, args *protocol.ContinueArguments) (*protocol.ContinueResponseBody, error)
+
+	// DataBreakpointInfo handles the 'dataBreakpointInfo' request, see protocol.DataBreakpointInfoRequest.
+	DataBreakpointInfo(ctx context.Context, args *protocol.DataBreakpointInfoArguments) (*protocol.DataBreakpointInfoResponseBody, error)
+
+	// Disassemble handles the 'disassemble' request, see protocol.DisassembleRequest.
+	Disassemble(ctx context.Context, args *protocol.DisassembleArguments) (*protocol.DisassembleResponseBody, error)
+
+	// Disconnect handles the 'disconnect' request, see protocol.DisconnectRequest.
+	Disconnect(ctx context.Context, args *protocol.DisconnectArguments) error
+
+	// Evaluate handles the 'evaluate' request, see protocol.EvaluateRequest.
+	Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error)
+
+	// ExceptionInfo handles the 'exceptionInfo' request, see protocol.ExceptionInfoRequest.
+	ExceptionInfo(ctx context.Context, args *protocol.ExceptionInfoArguments) (*protocol.ExceptionInfoResponseBody, error)
+
+	// Goto handles the 'goto' request, see protocol.GotoRequest.
+	Goto(ctx context.Context, args *protocol.GotoArguments) error
+
+	// GotoTargets handles the 'gotoTargets' request, see protocol.GotoTargetsRequest.
+	GotoTargets(ctx context.Context, args *protocol.GotoTargetsArguments) (*protocol.GotoTargetsResponseBody, error)
+
+	// Initialize handles the 'initialize' request, see protocol.InitializeRequest.
+	Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error)
+
+	// Launch handles the 'launch' request, see protocol.LaunchRequest.
+	Launch(ctx context.Context, args *protocol.LaunchRequestArguments) error
+
+	// LoadedSources handles the 'loadedSources' request, see protocol.LoadedSourcesRequest.
+	LoadedSources(ctx context.Context, args *protocol.LoadedSourcesArguments) (*protocol.LoadedSourcesResponseBody, error)
+
+	// Locations handles the 'locations' request, see protocol.LocationsRequest.
+	Locations(ctx context.Context, args *protocol.LocationsArguments) (*protocol.LocationsResponseBody, error)
+
+	// Modules handles the 'modules' request, see protocol.ModulesRequest.
+	Modules(ctx context.Context, args *protocol.ModulesArguments) (*protocol.ModulesResponseBody, error)
+
+	// Next handles the 'next' request, see protocol.NextRequest.
+	Next(ctx context.Context, args *protocol.NextArguments) error
+
+	// Pause handles the 'pause' request, see protocol.PauseRequest.
+	Pause(ctx context.Context, args *protocol.PauseArguments) error
+
+	// ReadMemory handles the 'readMemory' request, see protocol.ReadMemoryRequest.
+	ReadMemory(ctx context.Context, args *protocol.ReadMemoryArguments) (*protocol.ReadMemoryResponseBody, error)
+
+	// Restart handles the 'restart' request, see protocol.RestartRequest.
+	Restart(ctx context.Context, args *protocol.RestartArguments) error
+
+	// RestartFrame handles the 'restartFrame' request, see protocol.RestartFrameRequest.
+	RestartFrame(ctx context.Context, args *protocol.RestartFrameArguments) error
+
+	// ReverseContinue handles the 'reverseContinue' request, see protocol.ReverseContinueRequest.
+	ReverseContinue(ctx context.Context, args *protocol.ReverseContinueArguments) error
+
+	// Scopes handles the 'scopes' request, see protocol.ScopesRequest.
+	Scopes(ctx context.Context, args *protocol.ScopesArguments) (*protocol.ScopesResponseBody, error)
+
+	// SetBreakpoints handles the 'setBreakpoints' request, see protocol.SetBreakpointsRequest.
+	SetBreakpoints(ctx context.Context, args *protocol.SetBreakpointsArguments) (*protocol.SetBreakpointsResponseBody, error)
+
+	// SetDataBreakpoints handles the 'setDataBreakpoints' request, see protocol.SetDataBreakpointsRequest.
+	SetDataBreakpoints(ctx context.Context, args *protocol.SetDataBreakpointsArguments) (*protocol.SetDataBreakpointsResponseBody, error)
+
+	// SetExceptionBreakpoints handles the 'setExceptionBreakpoints' request, see protocol.SetExceptionBreakpointsRequest.
+	SetExceptionBreakpoints(ctx context.Context, args *protocol.SetExceptionBreakpointsArguments) (*protocol.SetExceptionBreakpointsResponseBody, error)
+
+	// SetExpression handles the 'setExpression' request, see protocol.SetExpressionRequest.
+	SetExpression(ctx context.Context, args *protocol.SetExpressionArguments) (*protocol.SetExpressionResponseBody, error)
+
+	// SetFunctionBreakpoints handles the 'setFunctionBreakpoints' request, see protocol.SetFunctionBreakpointsRequest.
+	SetFunctionBreakpoints(ctx context.Context, args *protocol.SetFunctionBreakpointsArguments) (*protocol.SetFunctionBreakpointsResponseBody, error)
+
+	// SetInstructionBreakpoints handles the 'setInstructionBreakpoints' request, see protocol.SetInstructionBreakpointsRequest.
+	SetInstructionBreakpoints(ctx context.Context, args *protocol.SetInstructionBreakpointsArguments) (*protocol.SetInstructionBreakpointsResponseBody, error)
+
+	// SetVariable handles the 'setVariable' request, see protocol.SetVariableRequest.
+	SetVariable(ctx context.Context, args *protocol.SetVariableArguments) (*protocol.SetVariableResponseBody, error)
+
+	// Source handles the 'source' request, see protocol.SourceRequest.
+	Source(ctx context.Context, args *protocol.SourceArguments) (*protocol.SourceResponseBody, error)
+
+	// StackTrace handles the 'stackTrace' request, see protocol.StackTraceRequest.
+	StackTrace(ctx context.Context, args *protocol.StackTraceArguments) (*protocol.StackTraceResponseBody, error)
+
+	// StepBack handles the 'stepBack' request, see protocol.StepBackRequest.
+	StepBack(ctx context.Context, args *protocol.StepBackArguments) error
+
+	// StepIn handles the 'stepIn' request, see protocol.StepInRequest.
+	StepIn(ctx context.Context, args *protocol.StepInArguments) error
+
+	// StepInTargets handles the 'stepInTargets' request, see protocol.StepInTargetsRequest.
+	StepInTargets(ctx context.Context, args *protocol.StepInTargetsArguments) (*protocol.StepInTargetsResponseBody, error)
+
+	// StepOut handles the 'stepOut' request, see protocol.StepOutRequest.
+	StepOut(ctx context.Context, args *protocol.StepOutArguments) error
+
+	// Terminate handles the 'terminate' request, see protocol.TerminateRequest.
+	Terminate(ctx context.Context, args *protocol.TerminateArguments) error
+
+	// TerminateThreads handles the 'terminateThreads' request, see protocol.TerminateThreadsRequest.
+	TerminateThreads(ctx context.Context, args *protocol.TerminateThreadsArguments) error
+
+	// Threads handles the 'threads' request, see protocol.ThreadsRequest.
+	Threads(ctx context.Context) (*protocol.ThreadsResponseBody, error)
+
+	// Variables handles the 'variables' request, see protocol.VariablesRequest.
+	Variables(ctx context.Context, args *protocol.VariablesArguments) (*protocol.VariablesResponseBody, error)
+
+	// WriteMemory handles the 'writeMemory' request, see protocol.WriteMemoryRequest.
+	WriteMemory(ctx context.Context, args *protocol.WriteMemoryArguments) (*protocol.WriteMemoryResponseBody, error)
+}
+
+// UnimplementedHandler is a Handler which does not support any request.
+// Its methods return ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+// Attach implements Handler.
+func (UnimplementedHandler) Attach(ctx context.Context, args *protocol.AttachRequestArguments) error {
+	return ErrNotImplemented
+}
+
+// BreakpointLocations implements Handler.
+func (UnimplementedHandler) BreakpointLocations(ctx context.Context, args *protocol.BreakpointLocationsArguments) (*protocol.BreakpointLocationsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Cancel implements Handler.
+func (UnimplementedHandler) Cancel(ctx context.Context, args *protocol.CancelArguments) error {
+	return ErrNotImplemented
+}
+
+// Completions implements Handler.
+func (UnimplementedHandler) Completions(ctx context.Context, args *protocol.CompletionsArguments) (*protocol.CompletionsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// ConfigurationDone implements Handler.
+func (UnimplementedHandler) ConfigurationDone(ctx context.Context, args *protocol.ConfigurationDoneArguments) error {
+	return ErrNotImplemented
+}
+
+// Continue implements Handler.
+func (UnimplementedHandler) Continue(ctx context.Context, args *protocol.ContinueArguments) (*protocol.ContinueResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// DataBreakpointInfo implements Handler.
+func (UnimplementedHandler) DataBreakpointInfo(ctx context.Context, args *protocol.DataBreakpointInfoArguments) (*protocol.DataBreakpointInfoResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Disassemble implements Handler.
+func (UnimplementedHandler) Disassemble(ctx context.Context, args *protocol.DisassembleArguments) (*protocol.DisassembleResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Disconnect implements Handler.
+func (UnimplementedHandler) Disconnect(ctx context.Context, args *protocol.DisconnectArguments) error {
+	return ErrNotImplemented
+}
+
+// Evaluate implements Handler.
+func (UnimplementedHandler) Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// ExceptionInfo implements Handler.
+func (UnimplementedHandler) ExceptionInfo(ctx context.Context, args *protocol.ExceptionInfoArguments) (*protocol.ExceptionInfoResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Goto implements Handler.
+func (UnimplementedHandler) Goto(ctx context.Context, args *protocol.GotoArguments) error {
+	return ErrNotImplemented
+}
+
+// GotoTargets implements Handler.
+func (UnimplementedHandler) GotoTargets(ctx context.Context, args *protocol.GotoTargetsArguments) (*protocol.GotoTargetsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Initialize implements Handler.
+func (UnimplementedHandler) Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error) {
+	return nil, ErrNotImplemented
+}
+
+// Launch implements Handler.
+func (UnimplementedHandler) Launch(ctx context.Context, args *protocol.LaunchRequestArguments) error {
+	return ErrNotImplemented
+}
+
+// LoadedSources implements Handler.
+func (UnimplementedHandler) LoadedSources(ctx context.Context, args *protocol.LoadedSourcesArguments) (*protocol.LoadedSourcesResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Locations implements Handler.
+func (UnimplementedHandler) Locations(ctx context.Context, args *protocol.LocationsArguments) (*protocol.LocationsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Modules implements Handler.
+func (UnimplementedHandler) Modules(ctx context.Context, args *protocol.ModulesArguments) (*protocol.ModulesResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Next implements Handler.
+func (UnimplementedHandler) Next(ctx context.Context, args *protocol.NextArguments) error {
+	return ErrNotImplemented
+}
+
+// Pause implements Handler.
+func (UnimplementedHandler) Pause(ctx context.Context, args *protocol.PauseArguments) error {
+	return ErrNotImplemented
+}
+
+// ReadMemory implements Handler.
+func (UnimplementedHandler) ReadMemory(ctx context.Context, args *protocol.ReadMemoryArguments) (*protocol.ReadMemoryResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Restart implements Handler.
+func (UnimplementedHandler) Restart(ctx context.Context, args *protocol.RestartArguments) error {
+	return ErrNotImplemented
+}
+
+// RestartFrame implements Handler.
+func (UnimplementedHandler) RestartFrame(ctx context.Context, args *protocol.RestartFrameArguments) error {
+	return ErrNotImplemented
+}
+
+// ReverseContinue implements Handler.
+func (UnimplementedHandler) ReverseContinue(ctx context.Context, args *protocol.ReverseContinueArguments) error {
+	return ErrNotImplemented
+}
+
+// Scopes implements Handler.
+func (UnimplementedHandler) Scopes(ctx context.Context, args *protocol.ScopesArguments) (*protocol.ScopesResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetBreakpoints implements Handler.
+func (UnimplementedHandler) SetBreakpoints(ctx context.Context, args *protocol.SetBreakpointsArguments) (*protocol.SetBreakpointsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetDataBreakpoints implements Handler.
+func (UnimplementedHandler) SetDataBreakpoints(ctx context.Context, args *protocol.SetDataBreakpointsArguments) (*protocol.SetDataBreakpointsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetExceptionBreakpoints implements Handler.
+func (UnimplementedHandler) SetExceptionBreakpoints(ctx context.Context, args *protocol.SetExceptionBreakpointsArguments) (*protocol.SetExceptionBreakpointsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetExpression implements Handler.
+func (UnimplementedHandler) SetExpression(ctx context.Context, args *protocol.SetExpressionArguments) (*protocol.SetExpressionResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetFunctionBreakpoints implements Handler.
+func (UnimplementedHandler) SetFunctionBreakpoints(ctx context.Context, args *protocol.SetFunctionBreakpointsArguments) (*protocol.SetFunctionBreakpointsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetInstructionBreakpoints implements Handler.
+func (UnimplementedHandler) SetInstructionBreakpoints(ctx context.Context, args *protocol.SetInstructionBreakpointsArguments) (*protocol.SetInstructionBreakpointsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// SetVariable implements Handler.
+func (UnimplementedHandler) SetVariable(ctx context.Context, args *protocol.SetVariableArguments) (*protocol.SetVariableResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Source implements Handler.
+func (UnimplementedHandler) Source(ctx context.Context, args *protocol.SourceArguments) (*protocol.SourceResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// StackTrace implements Handler.
+func (UnimplementedHandler) StackTrace(ctx context.Context, args *protocol.StackTraceArguments) (*protocol.StackTraceResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// StepBack implements Handler.
+func (UnimplementedHandler) StepBack(ctx context.Context, args *protocol.StepBackArguments) error {
+	return ErrNotImplemented
+}
+
+// StepIn implements Handler.
+func (UnimplementedHandler) StepIn(ctx context.Context, args *protocol.StepInArguments) error {
+	return ErrNotImplemented
+}
+
+// StepInTargets implements Handler.
+func (UnimplementedHandler) StepInTargets(ctx context.Context, args *protocol.StepInTargetsArguments) (*protocol.StepInTargetsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// StepOut implements Handler.
+func (UnimplementedHandler) StepOut(ctx context.Context, args *protocol.StepOutArguments) error {
+	return ErrNotImplemented
+}
+
+// Terminate implements Handler.
+func (UnimplementedHandler) Terminate(ctx context.Context, args *protocol.TerminateArguments) error {
+	return ErrNotImplemented
+}
+
+// TerminateThreads implements Handler.
+func (UnimplementedHandler) TerminateThreads(ctx context.Context, args *protocol.TerminateThreadsArguments) error {
+	return ErrNotImplemented
+}
+
+// Threads implements Handler.
+func (UnimplementedHandler) Threads(ctx context.Context) (*protocol.ThreadsResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// Variables implements Handler.
+func (UnimplementedHandler) Variables(ctx context.Context, args *protocol.VariablesArguments) (*protocol.VariablesResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// WriteMemory implements Handler.
+func (UnimplementedHandler) WriteMemory(ctx context.Context, args *protocol.WriteMemoryArguments) (*protocol.WriteMemoryResponseBody, error) {
+	return nil, ErrNotImplemented
+}
+
+// handlers maps the command of a request to the function calling the Handler method for it.
+var handlers = map[string]handlerFunc{
+	"attach": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.AttachRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.Attach(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.AttachResponse{Response: resp}, nil
+	},
+	"breakpointLocations": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		body, err := h.BreakpointLocations(ctx, req.(*protocol.BreakpointLocationsRequest).Arguments)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.BreakpointLocationsResponse{Response: resp, Body: body}, nil
+	},
+	"cancel": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		if err := h.Cancel(ctx, req.(*protocol.CancelRequest).Arguments); err != nil {
+			return nil, err
+		}
+		return &protocol.CancelResponse{Response: resp}, nil
+	},
+	"completions": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.CompletionsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Completions(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.CompletionsResponse{Response: resp, Body: body}, nil
+	},
+	"configurationDone": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		if err := h.ConfigurationDone(ctx, req.(*protocol.ConfigurationDoneRequest).Arguments); err != nil {
+			return nil, err
+		}
+		return &protocol.ConfigurationDoneResponse{Response: resp}, nil
+	},
+	"continue": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ContinueRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Continue(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ContinueResponse{Response: resp, Body: body}, nil
+	},
+	"dataBreakpointInfo": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.DataBreakpointInfoRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.DataBreakpointInfo(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.DataBreakpointInfoResponse{Response: resp, Body: body}, nil
+	},
+	"disassemble": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.DisassembleRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Disassemble(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.DisassembleResponse{Response: resp, Body: body}, nil
+	},
+	"disconnect": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		if err := h.Disconnect(ctx, req.(*protocol.DisconnectRequest).Arguments); err != nil {
+			return nil, err
+		}
+		return &protocol.DisconnectResponse{Response: resp}, nil
+	},
+	"evaluate": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.EvaluateRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Evaluate(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.EvaluateResponse{Response: resp, Body: body}, nil
+	},
+	"exceptionInfo": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ExceptionInfoRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.ExceptionInfo(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ExceptionInfoResponse{Response: resp, Body: body}, nil
+	},
+	"goto": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.GotoRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.Goto(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.GotoResponse{Response: resp}, nil
+	},
+	"gotoTargets": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.GotoTargetsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.GotoTargets(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.GotoTargetsResponse{Response: resp, Body: body}, nil
+	},
+	"initialize": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.InitializeRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Initialize(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.InitializeResponse{Response: resp, Body: body}, nil
+	},
+	"launch": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.LaunchRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.Launch(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.LaunchResponse{Response: resp}, nil
+	},
+	"loadedSources": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		body, err := h.LoadedSources(ctx, req.(*protocol.LoadedSourcesRequest).Arguments)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.LoadedSourcesResponse{Response: resp, Body: body}, nil
+	},
+	"locations": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.LocationsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Locations(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.LocationsResponse{Response: resp, Body: body}, nil
+	},
+	"modules": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ModulesRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Modules(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ModulesResponse{Response: resp, Body: body}, nil
+	},
+	"next": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.NextRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.Next(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.NextResponse{Response: resp}, nil
+	},
+	"pause": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.PauseRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.Pause(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.PauseResponse{Response: resp}, nil
+	},
+	"readMemory": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ReadMemoryRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.ReadMemory(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ReadMemoryResponse{Response: resp, Body: body}, nil
+	},
+	"restart": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		if err := h.Restart(ctx, req.(*protocol.RestartRequest).Arguments); err != nil {
+			return nil, err
+		}
+		return &protocol.RestartResponse{Response: resp}, nil
+	},
+	"restartFrame": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.RestartFrameRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.RestartFrame(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.RestartFrameResponse{Response: resp}, nil
+	},
+	"reverseContinue": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ReverseContinueRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.ReverseContinue(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.ReverseContinueResponse{Response: resp}, nil
+	},
+	"scopes": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.ScopesRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Scopes(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ScopesResponse{Response: resp, Body: body}, nil
+	},
+	"setBreakpoints": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetBreakpointsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetBreakpoints(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetBreakpointsResponse{Response: resp, Body: body}, nil
+	},
+	"setDataBreakpoints": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetDataBreakpointsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetDataBreakpoints(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetDataBreakpointsResponse{Response: resp, Body: body}, nil
+	},
+	"setExceptionBreakpoints": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetExceptionBreakpointsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetExceptionBreakpoints(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetExceptionBreakpointsResponse{Response: resp, Body: body}, nil
+	},
+	"setExpression": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetExpressionRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetExpression(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetExpressionResponse{Response: resp, Body: body}, nil
+	},
+	"setFunctionBreakpoints": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetFunctionBreakpointsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetFunctionBreakpoints(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetFunctionBreakpointsResponse{Response: resp, Body: body}, nil
+	},
+	"setInstructionBreakpoints": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetInstructionBreakpointsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetInstructionBreakpoints(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetInstructionBreakpointsResponse{Response: resp, Body: body}, nil
+	},
+	"setVariable": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SetVariableRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.SetVariable(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SetVariableResponse{Response: resp, Body: body}, nil
+	},
+	"source": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.SourceRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Source(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.SourceResponse{Response: resp, Body: body}, nil
+	},
+	"stackTrace": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.StackTraceRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.StackTrace(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.StackTraceResponse{Response: resp, Body: body}, nil
+	},
+	"stepBack": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.StepBackRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.StepBack(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.StepBackResponse{Response: resp}, nil
+	},
+	"stepIn": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.StepInRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.StepIn(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.StepInResponse{Response: resp}, nil
+	},
+	"stepInTargets": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.StepInTargetsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.StepInTargets(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.StepInTargetsResponse{Response: resp, Body: body}, nil
+	},
+	"stepOut": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.StepOutRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.StepOut(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.StepOutResponse{Response: resp}, nil
+	},
+	"terminate": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		if err := h.Terminate(ctx, req.(*protocol.TerminateRequest).Arguments); err != nil {
+			return nil, err
+		}
+		return &protocol.TerminateResponse{Response: resp}, nil
+	},
+	"terminateThreads": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.TerminateThreadsRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		if err := h.TerminateThreads(ctx, args); err != nil {
+			return nil, err
+		}
+		return &protocol.TerminateThreadsResponse{Response: resp}, nil
+	},
+	"threads": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		body, err := h.Threads(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.ThreadsResponse{Response: resp, Body: body}, nil
+	},
+	"variables": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.VariablesRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.Variables(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.VariablesResponse{Response: resp, Body: body}, nil
+	},
+	"writeMemory": func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {
+		args := req.(*protocol.WriteMemoryRequest).Arguments
+		if args == nil {
+			return nil, errMissingArguments
+		}
+		body, err := h.WriteMemory(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return &protocol.WriteMemoryResponse{Response: resp, Body: body}, nil
+	},
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// Policy decides which requests a Session handles concurrently.
+type Policy interface {
+	// Queue returns the name of the queue of req.
+	// The requests of the same queue are handled one at a time in the order they are received,
+	// and the requests of different queues are handled concurrently.
+	// If Queue returns "", req is handled concurrently with all the other requests.
+	Queue(req protocol.RequestMessage) string
+}
+
+// PolicyFunc is a function implementing Policy.
+type PolicyFunc func(req protocol.RequestMessage) string
+
+// Queue implements Policy.
+func (f PolicyFunc) Queue(req protocol.RequestMessage) string {
+	return f(req)
+}
+
+// Serial is the Policy handling all the requests one at a time in the order they are received.
+// It is the default policy of Session, under which the Handler methods are never called concurrently.
+var Serial Policy = PolicyFunc(func(protocol.RequestMessage) string {
+	return "serial"
+})
+
+// ConcurrentPolicy is a Policy handling the requests which do not change the state of the debuggee concurrently.
+//
+// The requests of the Concurrent commands are handled concurrently with all the other requests,
+// the requests of the PerThread commands are handled in order with the other requests for the same thread,
+// and the other requests are handled one at a time in the order they are received.
+// The Handler methods of the Concurrent and PerThread commands must be safe for concurrent use.
+//
+// For example, the following policy lets 'evaluate' and 'variables' run in parallel
+// while the stepping requests are serialized per thread:
+//
+//	&adapter.ConcurrentPolicy{
+//		Concurrent: map[string]bool{"evaluate": true, "variables": true},
+//		PerThread:  map[string]bool{"continue": true, "next": true, "stepIn": true, "stepOut": true},
+//	}
+type ConcurrentPolicy struct {
+	// Concurrent is the set of the commands whose requests are handled concurrently with all the others.
+	Concurrent map[string]bool
+
+	// PerThread is the set of the commands whose requests are serialized per thread,
+	// identified by the threadId of their arguments.
+	PerThread map[string]bool
+}
+
+// Queue implements Policy.
+func (p *ConcurrentPolicy) Queue(req protocol.RequestMessage) string {
+	command := req.GetCommand()
+	switch {
+	case p.Concurrent[command]:
+		return ""
+	case p.PerThread[command]:
+		if id, ok := threadID(req); ok {
+			return "thread " + strconv.Itoa(id)
+		}
+	}
+	return "serial"
+}
+
+// threadID returns the threadId of the arguments of req if any.
+func threadID(req protocol.RequestMessage) (int, bool) {
+	args := reflect.ValueOf(req.GetArguments())
+	if args.Kind() != reflect.Ptr || args.IsNil() || args.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	f := args.Elem().FieldByName("ThreadId")
+	if f.Kind() != reflect.Int {
+		return 0, false
+	}
+	return int(f.Int()), true
+}
@@ -0,0 +1,416 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-language-server/dap/protocol"
+	"github.com/go-language-server/dap/transport"
+)
+
+// policyHandler records the calls of its methods.
+// The hooks, keyed by the label of a call, are called while the call is in flight.
+type policyHandler struct {
+	UnimplementedHandler
+
+	hooks map[string]func()
+
+	mu       sync.Mutex
+	calls    []string
+	inFlight int
+	max      int
+}
+
+func (h *policyHandler) run(label string) {
+	h.mu.Lock()
+	h.calls = append(h.calls, label)
+	h.inFlight++
+	if h.inFlight > h.max {
+		h.max = h.inFlight
+	}
+	h.mu.Unlock()
+
+	if hook := h.hooks[label]; hook != nil {
+		hook()
+	} else {
+		time.Sleep(time.Millisecond)
+	}
+
+	h.mu.Lock()
+	h.inFlight--
+	h.mu.Unlock()
+}
+
+func (h *policyHandler) Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error) {
+	return &protocol.Capabilities{SupportsConfigurationDoneRequest: true}, nil
+}
+
+func (h *policyHandler) ConfigurationDone(ctx context.Context, args *protocol.ConfigurationDoneArguments) error {
+	return nil
+}
+
+func (h *policyHandler) Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error) {
+	h.run("evaluate " + args.Expression)
+	return &protocol.EvaluateResponseBody{Result: args.Expression}, nil
+}
+
+func (h *policyHandler) Variables(ctx context.Context, args *protocol.VariablesArguments) (*protocol.VariablesResponseBody, error) {
+	h.run("variables " + strconv.Itoa(args.VariablesReference))
+	return &protocol.VariablesResponseBody{Variables: []*protocol.Variable{}}, nil
+}
+
+func (h *policyHandler) Next(ctx context.Context, args *protocol.NextArguments) error {
+	h.run("next " + strconv.Itoa(args.ThreadId))
+	return nil
+}
+
+func (h *policyHandler) Continue(ctx context.Context, args *protocol.ContinueArguments) (*protocol.ContinueResponseBody, error) {
+	h.run("continue " + strconv.Itoa(args.ThreadId))
+	return &protocol.ContinueResponseBody{}, nil
+}
+
+func (h *policyHandler) Threads(ctx context.Context) (*protocol.ThreadsResponseBody, error) {
+	h.run("threads")
+	return &protocol.ThreadsResponseBody{Threads: []*protocol.Thread{}}, nil
+}
+
+func (h *policyHandler) Modules(ctx context.Context, args *protocol.ModulesArguments) (*protocol.ModulesResponseBody, error) {
+	h.run("modules")
+	return &protocol.ModulesResponseBody{Modules: []*protocol.Module{}}, nil
+}
+
+func (h *policyHandler) recorded() ([]string, int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.calls...), h.max
+}
+
+// policyClient is the client end of a Session.
+// It receives the responses, the events and the reverse requests on their channel,
+// and records the messages received in order.
+type policyClient struct {
+	t         *testing.T
+	stream    transport.Stream
+	seq       int
+	responses chan *protocol.Response
+	events    chan *protocol.Event
+	requests  chan *protocol.Request
+	served    chan struct{}
+
+	mu       sync.Mutex
+	received []string // the type and the command or event of the messages, such as "event initialized"
+}
+
+// startSession serves the client with s and runs it to the configured state.
+func startSession(t *testing.T, s *Session) *policyClient {
+	t.Helper()
+	a, b := transport.Pipe()
+	c := connect(t, s, a, b)
+	c.handshake()
+	return c
+}
+
+// connect serves the client end b of the connection with s, which serves the end a.
+func connect(t *testing.T, s *Session, a, b transport.Stream) *policyClient {
+	c := &policyClient{
+		t:         t,
+		stream:    b,
+		responses: make(chan *protocol.Response, 100),
+		events:    make(chan *protocol.Event, 100),
+		requests:  make(chan *protocol.Request, 100),
+		served:    make(chan struct{}),
+	}
+	go func() {
+		defer close(c.served)
+		if err := s.ServeStream(context.Background(), a); err != nil {
+			t.Error(err)
+		}
+	}()
+	go func() {
+		defer close(c.responses)
+		for {
+			data, err := b.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := c.receive(data); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// receive passes the message data to the channel of its type.
+func (c *policyClient) receive(data []byte) error {
+	var m protocol.ProtocolMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	switch m.Type {
+	case protocol.ProtocolMessageTypeResponse:
+		var resp protocol.Response
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		c.record("response " + resp.Command)
+		c.responses <- &resp
+	case protocol.ProtocolMessageTypeEvent:
+		var event protocol.Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		c.record("event " + event.Event)
+		c.events <- &event
+	case protocol.ProtocolMessageTypeRequest:
+		var req protocol.Request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return err
+		}
+		c.record("request " + req.Command)
+		c.requests <- &req
+	}
+	return nil
+}
+
+func (c *policyClient) record(msg string) {
+	c.mu.Lock()
+	c.received = append(c.received, msg)
+	c.mu.Unlock()
+}
+
+// handshake initializes and configures the session.
+func (c *policyClient) handshake() {
+	c.t.Helper()
+	c.wait(c.send("initialize", &protocol.InitializeRequestArguments{AdapterID: "test"}))
+	c.event("initialized")
+	c.wait(c.send("configurationDone", nil))
+}
+
+// send sends the request of command with args and returns its sequence number.
+func (c *policyClient) send(command string, args interface{}) int {
+	c.t.Helper()
+	c.seq++
+	req := map[string]interface{}{"seq": c.seq, "type": "request", "command": command}
+	if args != nil {
+		req["arguments"] = args
+	}
+	c.write(req)
+	return c.seq
+}
+
+// write writes the message msg encoded in JSON.
+func (c *policyClient) write(msg interface{}) {
+	c.t.Helper()
+	data, err := json.Marshal(msg)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := c.stream.WriteMessage(data); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// collect waits for the responses to the requests seqs, in any order, and returns them by request seq.
+func (c *policyClient) collect(seqs ...int) map[int]*protocol.Response {
+	c.t.Helper()
+	want := make(map[int]bool)
+	for _, seq := range seqs {
+		want[seq] = true
+	}
+	got := make(map[int]*protocol.Response)
+	timeout := time.After(5 * time.Second)
+	for len(got) < len(want) {
+		select {
+		case resp, ok := <-c.responses:
+			if !ok {
+				c.t.Fatal("connection closed")
+			}
+			if !want[resp.RequestSeq] {
+				c.t.Fatalf("unexpected response to %s request %d", resp.Command, resp.RequestSeq)
+			}
+			got[resp.RequestSeq] = resp
+		case <-timeout:
+			c.t.Fatalf("timed out waiting for the responses to %v", seqs)
+		}
+	}
+	return got
+}
+
+// wait waits for the successful responses to the requests seqs, in any order.
+func (c *policyClient) wait(seqs ...int) {
+	c.t.Helper()
+	for _, resp := range c.collect(seqs...) {
+		if !resp.Success {
+			c.t.Fatalf("%s request %d failed: %s", resp.Command, resp.RequestSeq, resp.Message)
+		}
+	}
+}
+
+// event waits for the next event, which must be of type name.
+func (c *policyClient) event(name string) *protocol.Event {
+	c.t.Helper()
+	select {
+	case event := <-c.events:
+		if event.Event != name {
+			c.t.Fatalf("got %q event, want %q", event.Event, name)
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		c.t.Fatalf("timed out waiting for the %q event", name)
+	}
+	return nil
+}
+
+// close closes the connection and waits for the session to finish.
+func (c *policyClient) close() {
+	c.stream.Close()
+	<-c.served
+}
+
+func TestSerial(t *testing.T) {
+	h := new(policyHandler)
+	c := startSession(t, NewSession(h))
+	defer c.close()
+
+	var want []string
+	var seqs []int
+	for i := 1; i <= 5; i++ {
+		seqs = append(seqs,
+			c.send("evaluate", &protocol.EvaluateArguments{Expression: strconv.Itoa(i)}),
+			c.send("variables", &protocol.VariablesArguments{VariablesReference: i}),
+			c.send("next", &protocol.NextArguments{ThreadId: i}),
+			c.send("continue", &protocol.ContinueArguments{ThreadId: i}),
+			c.send("threads", nil),
+		)
+		want = append(want,
+			fmt.Sprintf("evaluate %d", i),
+			fmt.Sprintf("variables %d", i),
+			fmt.Sprintf("next %d", i),
+			fmt.Sprintf("continue %d", i),
+			"threads",
+		)
+	}
+	c.wait(seqs...)
+
+	calls, max := h.recorded()
+	if max != 1 {
+		t.Errorf("%d requests handled concurrently, want 1", max)
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("handled\n\t%v\nwant\n\t%v", calls, want)
+	}
+}
+
+// testPolicy is the Policy of the example of ConcurrentPolicy.
+func testPolicy() *ConcurrentPolicy {
+	return &ConcurrentPolicy{
+		Concurrent: map[string]bool{"evaluate": true, "variables": true},
+		PerThread:  map[string]bool{"continue": true, "next": true, "stepIn": true, "stepOut": true},
+	}
+}
+
+func TestConcurrentPolicyConcurrent(t *testing.T) {
+	// Each request waits for the other to start, which never happens if they are serialized.
+	evaluating, listing := make(chan struct{}), make(chan struct{})
+	h := &policyHandler{
+		hooks: map[string]func(){
+			"evaluate x": func() {
+				close(evaluating)
+				<-listing
+			},
+			"variables 7": func() {
+				close(listing)
+				<-evaluating
+			},
+		},
+	}
+	s := NewSession(h)
+	s.Policy = testPolicy()
+	c := startSession(t, s)
+	defer c.close()
+
+	c.wait(
+		c.send("evaluate", &protocol.EvaluateArguments{Expression: "x"}),
+		c.send("variables", &protocol.VariablesArguments{VariablesReference: 7}),
+	)
+	if _, max := h.recorded(); max != 2 {
+		t.Errorf("%d requests handled concurrently, want 2", max)
+	}
+}
+
+func TestConcurrentPolicyPerThread(t *testing.T) {
+	release := make(chan struct{})
+	h := &policyHandler{
+		hooks: map[string]func(){
+			"next 1": func() { <-release },
+		},
+	}
+	s := NewSession(h)
+	s.Policy = testPolicy()
+	c := startSession(t, s)
+
+	next1 := c.send("next", &protocol.NextArguments{ThreadId: 1})
+	continue1 := c.send("continue", &protocol.ContinueArguments{ThreadId: 1})
+	// The requests for thread 2 are not held by the request for thread 1 being handled.
+	c.wait(
+		c.send("next", &protocol.NextArguments{ThreadId: 2}),
+		c.send("continue", &protocol.ContinueArguments{ThreadId: 2}),
+	)
+	close(release)
+	c.wait(next1, continue1)
+	c.close()
+
+	// The requests for thread 2 may be handled before or after 'next' for thread 1 starts.
+	calls, _ := h.recorded()
+	index := make(map[string]int)
+	for i, call := range calls {
+		index[call] = i
+	}
+	if len(calls) != 4 || index["next 2"] > index["continue 2"] || index["continue 1"] != 3 {
+		t.Errorf("handled %v, want the requests of each thread in order", calls)
+	}
+	if len(s.queues) != 0 {
+		t.Errorf("%d queues left after the requests have been handled", len(s.queues))
+	}
+}
+
+func TestConcurrentPolicySerial(t *testing.T) {
+	h := new(policyHandler)
+	s := NewSession(h)
+	s.Policy = testPolicy()
+	c := startSession(t, s)
+	defer c.close()
+
+	var want []string
+	var seqs []int
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			seqs = append(seqs, c.send("threads", nil))
+			want = append(want, "threads")
+		} else {
+			seqs = append(seqs, c.send("modules", &protocol.ModulesArguments{}))
+			want = append(want, "modules")
+		}
+	}
+	c.wait(seqs...)
+
+	calls, max := h.recorded()
+	if max != 1 {
+		t.Errorf("%d requests handled concurrently, want 1", max)
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("handled\n\t%v\nwant\n\t%v", calls, want)
+	}
+}
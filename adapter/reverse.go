@@ -0,0 +1,105 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// call is a reverse request waiting for its response.
+type call struct {
+	done chan struct{} // closed when resp or err is set
+	resp protocol.ResponseMessage
+	err  error
+}
+
+// SendRequest sends the reverse request req to the client and waits for its response.
+//
+// The type, command and sequence number of req are set by SendRequest.
+// If the client replies with an error response, SendRequest returns it along with an *Error describing it.
+// SendRequest returns the error of ctx if ctx is done before the response is received,
+// and ErrDisconnected if the connection is closed.
+func (s *Session) SendRequest(ctx context.Context, req protocol.RequestMessage) (protocol.ResponseMessage, error) {
+	c := &call{done: make(chan struct{})}
+	if err := s.write(req, c); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		s.mu.Lock()
+		delete(s.pending, req.GetSeq())
+		s.mu.Unlock()
+		return nil, ctx.Err()
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	if !c.resp.GetSuccess() {
+		return c.resp, responseError(c.resp)
+	}
+	return c.resp, nil
+}
+
+// complete completes the reverse request of sequence number seq with its response resp or the error err.
+// The responses to the unknown requests are ignored.
+func (s *Session) complete(seq int, resp protocol.ResponseMessage, err error) {
+	s.mu.Lock()
+	c, ok := s.pending[seq]
+	delete(s.pending, seq)
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	c.resp, c.err = resp, err
+	close(c.done)
+}
+
+// failPending fails the reverse requests waiting for their response with ErrDisconnected
+// and makes the later ones fail as well.
+func (s *Session) failPending() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	for _, c := range pending {
+		c.err = ErrDisconnected
+		close(c.done)
+	}
+}
+
+// responseError returns the *Error describing the error response resp.
+func responseError(resp protocol.ResponseMessage) *Error {
+	if r, ok := resp.(*protocol.ErrorResponse); ok && r.Body != nil && r.Body.Error != nil {
+		return &Error{ErrorMessage: *r.Body.Error}
+	}
+	return &Error{ErrorMessage: protocol.ErrorMessage{Format: resp.GetMessage()}}
+}
+
+// RunInTerminal asks the client to run the command described by args in a terminal
+// and returns the ID of the process started.
+//
+// It should only be sent if the client capability 'supportsRunInTerminalRequest' is true.
+func (s *Session) RunInTerminal(ctx context.Context, args *protocol.RunInTerminalRequestArguments) (*protocol.RunInTerminalResponseBody, error) {
+	resp, err := s.SendRequest(ctx, &protocol.RunInTerminalRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := resp.(*protocol.RunInTerminalResponse); ok && r.Body != nil {
+		return r.Body, nil
+	}
+	return new(protocol.RunInTerminalResponseBody), nil
+}
+
+// StartDebugging asks the client to start a new debug session of the same type as the session.
+//
+// It should only be sent if the client capability 'supportsStartDebuggingRequest' is true.
+func (s *Session) StartDebugging(ctx context.Context, args *protocol.StartDebuggingRequestArguments) error {
+	_, err := s.SendRequest(ctx, &protocol.StartDebuggingRequest{Arguments: args})
+	return err
+}
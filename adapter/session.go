@@ -0,0 +1,448 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"runtime/debug"
+	"sync"
+
+	"github.com/go-language-server/dap/protocol"
+	"github.com/go-language-server/dap/transport"
+)
+
+// ErrNotImplemented is returned by the methods of UnimplementedHandler.
+// The client receives an error response to the request.
+var ErrNotImplemented = errors.New("not implemented")
+
+// errMissingArguments is the error of a request missing its required arguments.
+var errMissingArguments = errors.New("missing arguments")
+
+// ErrNotServing is returned when sending a message by a Session which is not serving a connection.
+var ErrNotServing = errors.New("adapter: session is not serving")
+
+// ErrDisconnected is returned by SendRequest when the connection to the client is closed
+// before the response to the request is received.
+var ErrDisconnected = errors.New("adapter: client disconnected")
+
+// Error is an error returned by a Handler to send the structured error message in the error response,
+// such as a message the client shows to the user.
+type Error struct {
+	protocol.ErrorMessage
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.Format
+}
+
+// handlerFunc calls the Handler method for the request req and returns its response built on resp.
+type handlerFunc func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error)
+
+// Session is a debug session of a debug adapter serving a client.
+// It is the runtime of DebugSession: it reads the requests of the client, dispatches them to the Handler,
+// and sends the responses and the events.
+//
+// The requests are scheduled by the Policy of the session, which handles them one at a time
+// in the order they are received by default.
+// The 'cancel' requests are handled as soon as they are received.
+// The context passed to the Handler method for a request is cancelled when the client cancels the request.
+// A panic of a Handler method is recovered, logged to ErrorLog and the request is replied with an error response.
+//
+// The session enforces the initialization sequence of the protocol, see State.
+// The requests out of sequence are replied with an error response without calling the Handler.
+// After the successful response to the 'initialize' request, the session sends the 'initialized' event
+// unless DeferInitialized is set. The 'initialized' event sent by the Handler while handling the request
+// is held until the response has been sent, as the protocol requires.
+type Session struct {
+	// Validator validates the messages received and sent by the session.
+	// In protocol.ValidateStrict mode, the invalid requests are replied with an error response
+	// and the invalid messages of the debug adapter are not sent.
+	// If nil, the messages are not validated.
+	Validator *protocol.Validator
+
+	// Policy decides which requests are handled concurrently.
+	// If nil, Serial is used.
+	Policy Policy
+
+	// DeferInitialized disables sending the 'initialized' event after the response to the 'initialize' request.
+	// The Handler sends it with SendEvent once it is ready to accept the configuration requests,
+	// such as after the debuggee is launched by the 'launch' request.
+	DeferInitialized bool
+
+	// ErrorLog logs the panics of the Handler methods with their stack trace,
+	// and the messages of the client which could not be handled.
+	// If nil, the standard logger is used.
+	ErrorLog *log.Logger
+
+	handler Handler
+
+	mu      sync.Mutex // guards out and pending
+	out     *protocol.SeqWriter
+	pending map[int]*call // reverse requests waiting for their response by seq
+
+	cancelMu   sync.Mutex                    // guards cancels and progresses
+	cancels    map[int]context.CancelFunc    // cancels the requests being handled by seq
+	progresses map[string]context.CancelFunc // cancels the progresses by progress ID
+
+	stateMu           sync.Mutex // guards state, capabilities, initializeReplied, initializedSent and initialized
+	state             State
+	capabilities      *protocol.Capabilities
+	initializeReplied bool                       // the successful response to 'initialize' has been sent
+	initializedSent   bool                       // the 'initialized' event has been sent or is held in initialized
+	initialized       *protocol.InitializedEvent // held until the response to 'initialize' has been sent
+
+	queueMu sync.Mutex               // guards queues
+	queues  map[string]chan struct{} // closed when the last request dispatched to the queue has been handled
+	wg      sync.WaitGroup
+}
+
+// NewSession returns a new Session dispatching the requests to h.
+func NewSession(h Handler) *Session {
+	return &Session{
+		handler:    h,
+		queues:     make(map[string]chan struct{}),
+		cancels:    make(map[int]context.CancelFunc),
+		progresses: make(map[string]context.CancelFunc),
+	}
+}
+
+// sessionKey is the context key of the Session handling a request.
+type sessionKey struct{}
+
+// SessionFromContext returns the Session handling the request whose Handler method received ctx,
+// or nil if there is none.
+func SessionFromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(sessionKey{}).(*Session)
+	return s
+}
+
+// Serve serves the client connected by rw until rw reaches EOF or ctx is done.
+//
+// The messages are framed by the base protocol of the Debug Adapter Protocol.
+// If rw implements io.Closer, it is closed when ctx is done to stop reading it.
+// Serve waits for the requests being handled to finish before returning.
+func (s *Session) Serve(ctx context.Context, rw io.ReadWriter) error {
+	return s.ServeStream(ctx, transport.NewStream(rw))
+}
+
+// ServeStream serves the client connected by stream until it reaches EOF or ctx is done,
+// such as a stream of the transport package.
+//
+// The stream is closed when ctx is done to stop reading it.
+// ServeStream waits for the requests being handled to finish before returning.
+func (s *Session) ServeStream(ctx context.Context, stream transport.Stream) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = context.WithValue(ctx, sessionKey{}, s)
+
+	s.mu.Lock()
+	s.out = protocol.NewSeqWriter(stream)
+	s.pending = make(map[int]*call)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.out = nil
+		s.mu.Unlock()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+
+	err := s.read(ctx, stream)
+	s.disconnected()
+	s.failPending()
+	cancel()
+	s.wg.Wait()
+	return err
+}
+
+// read reads and dispatches the messages from r until r reaches EOF.
+func (s *Session) read(ctx context.Context, r transport.Stream) error {
+	for {
+		data, err := r.ReadMessage()
+		var tooLarge *transport.MessageTooLargeError
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case errors.As(err, &tooLarge):
+			// The head of the message usually identifies it.
+			if !s.decodeError(tooLarge.Head, err) {
+				s.logf("dap: dropped message: %v", err)
+			}
+			continue
+		case err != nil:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		msg, err := protocol.DecodeMessage(data)
+		if err != nil {
+			if !s.decodeError(data, err) {
+				return fmt.Errorf("adapter: %w", err)
+			}
+			continue
+		}
+		if err := s.Validator.Check(msg); err != nil {
+			switch m := msg.(type) {
+			case protocol.RequestMessage:
+				s.sendError(newResponse(m), err)
+			case protocol.ResponseMessage:
+				s.complete(m.GetRequestSeq(), nil, err)
+			}
+			continue
+		}
+
+		switch m := msg.(type) {
+		case *protocol.CancelRequest:
+			s.cancel(ctx, m)
+		case protocol.RequestMessage:
+			s.dispatch(ctx, m)
+		case protocol.ResponseMessage:
+			s.complete(m.GetRequestSeq(), m, nil)
+		}
+	}
+}
+
+// decodeError handles the message data which could not be read or decoded because of err.
+// A request is replied with an error response and the reverse request of a response fails with err.
+// It returns false if data is not identified as a request or a response.
+func (s *Session) decodeError(data []byte, err error) bool {
+	h, herr := protocol.DecodeMessageHeader(data)
+	switch {
+	case herr != nil:
+		return false
+	case h.Type == protocol.ProtocolMessageTypeRequest && h.Seq != 0:
+		req := &protocol.Request{ProtocolMessage: h.ProtocolMessage, Command: h.Command}
+		s.sendError(newResponse(req), err)
+	case h.Type == protocol.ProtocolMessageTypeResponse && h.RequestSeq != 0:
+		s.complete(h.RequestSeq, nil, fmt.Errorf("adapter: %w", err))
+	default:
+		return false
+	}
+	return true
+}
+
+// dispatch handles req after the requests dispatched before it to the same queue.
+// The request can be cancelled from its dispatch.
+func (s *Session) dispatch(ctx context.Context, req protocol.RequestMessage) {
+	seq := req.GetSeq()
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancelMu.Lock()
+	s.cancels[seq] = cancel
+	s.cancelMu.Unlock()
+
+	policy := s.Policy
+	if policy == nil {
+		policy = Serial
+	}
+	var prev, done chan struct{}
+	queue := policy.Queue(req)
+	if queue != "" {
+		done = make(chan struct{})
+		s.queueMu.Lock()
+		prev = s.queues[queue]
+		s.queues[queue] = done
+		s.queueMu.Unlock()
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if done != nil {
+			defer s.leaveQueue(queue, done)
+		}
+		defer func() {
+			s.cancelMu.Lock()
+			delete(s.cancels, seq)
+			s.cancelMu.Unlock()
+			cancel()
+		}()
+		if prev != nil {
+			<-prev
+		}
+		s.handle(ctx, req)
+	}()
+}
+
+// leaveQueue marks the request of queue whose done channel is done as handled.
+// The queue is removed once its last request has been handled, so that the queues of the threads
+// which exited do not accumulate.
+func (s *Session) leaveQueue(queue string, done chan struct{}) {
+	s.queueMu.Lock()
+	if s.queues[queue] == done {
+		delete(s.queues, queue)
+	}
+	s.queueMu.Unlock()
+	close(done)
+}
+
+// handle calls the Handler method for req and sends its response.
+// The request is replied with a cancelled error response without being handled if ctx is already done.
+func (s *Session) handle(ctx context.Context, req protocol.RequestMessage) {
+	resp := newResponse(req)
+	if err := ctx.Err(); err != nil {
+		s.sendError(resp, err)
+		return
+	}
+	command := req.GetCommand()
+	call, ok := handlers[command]
+	if !ok {
+		s.sendError(resp, fmt.Errorf("unknown command %q", command))
+		return
+	}
+	if err := s.enter(command); err != nil {
+		s.sendError(resp, err)
+		return
+	}
+
+	m, err := s.call(ctx, call, req, resp)
+	s.leave(command, m)
+	s.reply(resp, m, err)
+	if command == "initialize" {
+		s.initializeDone(m != nil)
+	}
+}
+
+// call calls f for req, returning the panic of the Handler method as an error
+// so that a failing request does not bring the debug adapter down.
+func (s *Session) call(ctx context.Context, f handlerFunc, req protocol.RequestMessage, resp protocol.Response) (m protocol.ResponseMessage, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logf("dap: panic handling %q request %d: %v\n%s", req.GetCommand(), req.GetSeq(), r, debug.Stack())
+			m, err = nil, fmt.Errorf("internal error: %v", r)
+		}
+	}()
+	return f(ctx, s.handler, req, resp)
+}
+
+// logf logs the message with ErrorLog, or the standard logger if nil.
+func (s *Session) logf(format string, args ...interface{}) {
+	logf := log.Printf
+	if s.ErrorLog != nil {
+		logf = s.ErrorLog.Printf
+	}
+	logf(format, args...)
+}
+
+// reply sends the response m built on resp, or the error response for err if not nil.
+func (s *Session) reply(resp protocol.Response, m protocol.ResponseMessage, err error) {
+	if err != nil {
+		s.sendError(resp, err)
+		return
+	}
+	var invalid protocol.ValidationErrors
+	if err := s.send(m); errors.As(err, &invalid) {
+		s.sendError(resp, err)
+	}
+	// The other write errors mean the connection is lost, which the reader finds out as well.
+}
+
+// newResponse returns the successful response to req without a body.
+func newResponse(req protocol.RequestMessage) protocol.Response {
+	return protocol.Response{
+		ProtocolMessage: protocol.ProtocolMessage{
+			Type: protocol.ProtocolMessageTypeResponse,
+		},
+		Command:    req.GetCommand(),
+		RequestSeq: req.GetSeq(),
+		Success:    true,
+	}
+}
+
+// sendError sends the error response built on resp for err.
+// The message of the response is 'cancelled' if err is context.Canceled.
+func (s *Session) sendError(resp protocol.Response, err error) {
+	resp.Success = false
+	resp.Message = protocol.ResponseError(err.Error())
+	if errors.Is(err, context.Canceled) {
+		resp.Message = protocol.ResponseErrorCancelled
+	}
+	body := new(protocol.ErrorResponseBody)
+	var e *Error
+	if errors.As(err, &e) {
+		body.Error = &e.ErrorMessage
+	}
+	_ = s.send(&protocol.ErrorResponse{Response: resp, Body: body})
+}
+
+// SendEvent sends the event to the client.
+// The type and the event type of the event are set from its concrete type if they are empty.
+//
+// SendEvent may be called from any goroutine, such as the ones watching the debuggee.
+// The messages sent by the session are numbered in the order they are written.
+//
+// The 'initialized' event sent before the response to the 'initialize' request is held until the response has been sent.
+// Sending the 'terminated' event moves the session to StateTerminated.
+func (s *Session) SendEvent(event protocol.EventMessage) error {
+	switch e := event.(type) {
+	case *protocol.InitializedEvent:
+		s.stateMu.Lock()
+		s.initializedSent = true
+		if !s.initializeReplied {
+			s.initialized = e
+			s.stateMu.Unlock()
+			return nil
+		}
+		s.stateMu.Unlock()
+	case *protocol.TerminatedEvent:
+		s.terminated()
+	}
+	return s.send(event)
+}
+
+// send stamps msg with the next sequence number and writes it.
+func (s *Session) send(msg protocol.Message) error {
+	return s.write(msg, nil)
+}
+
+// write stamps msg with the next sequence number and writes it.
+// If c is not nil, it is registered as waiting for the response to the request msg
+// before msg is written, so that the response cannot be received before,
+// and msg is not written if the connection is closed already.
+func (s *Session) write(msg protocol.Message, c *call) error {
+	protocol.SetDefaults(msg)
+	if err := s.Validator.Check(msg); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	out := s.out
+	s.mu.Unlock()
+	if out == nil {
+		return ErrNotServing
+	}
+	if c == nil {
+		return out.Write(msg)
+	}
+	err := out.WriteFunc(msg, func(seq int) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.pending == nil {
+			return ErrDisconnected
+		}
+		s.pending[seq] = c
+		return nil
+	})
+	if err != nil && err != ErrDisconnected {
+		s.mu.Lock()
+		delete(s.pending, msg.GetSeq())
+		s.mu.Unlock()
+	}
+	return err
+}
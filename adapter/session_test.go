@@ -0,0 +1,448 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-language-server/dap/protocol"
+	"github.com/go-language-server/dap/transport"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use, such as the output of a Session.ErrorLog.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// failure waits for the response to the request seq and returns its error message,
+// failing the test if the request succeeded.
+func (c *policyClient) failure(seq int) string {
+	c.t.Helper()
+	resp := c.collect(seq)[seq]
+	if resp.Success {
+		c.t.Fatalf("%s request %d succeeded", resp.Command, seq)
+	}
+	return string(resp.Message)
+}
+
+// request waits for the next reverse request.
+func (c *policyClient) request() *protocol.Request {
+	c.t.Helper()
+	select {
+	case req := <-c.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		c.t.Fatal("timed out waiting for a reverse request")
+	}
+	return nil
+}
+
+// reply sends the response to the reverse request req with body.
+func (c *policyClient) reply(req *protocol.Request, body interface{}) {
+	c.t.Helper()
+	c.seq++
+	c.write(map[string]interface{}{
+		"seq": c.seq, "type": "response", "request_seq": req.Seq, "command": req.Command, "success": true, "body": body,
+	})
+}
+
+// messages returns the messages received so far, see policyClient.received.
+func (c *policyClient) messages() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.received...)
+}
+
+// sessionHandler is a policyHandler whose 'evaluate' requests wait for their cancellation,
+// reporting the expression evaluated on started.
+type sessionHandler struct {
+	policyHandler
+
+	started chan string
+
+	// sendInitialized makes 'initialize' send the 'initialized' event before its response.
+	sendInitialized bool
+}
+
+func newSessionHandler() *sessionHandler {
+	return &sessionHandler{started: make(chan string, 10)}
+}
+
+func (h *sessionHandler) Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error) {
+	if h.sendInitialized {
+		if err := SessionFromContext(ctx).SendEvent(new(protocol.InitializedEvent)); err != nil {
+			return nil, err
+		}
+	}
+	return h.policyHandler.Initialize(ctx, args)
+}
+
+// Evaluate waits for the request to be cancelled. The expression "progress" reports a progress
+// and waits for the progress to be cancelled instead.
+func (h *sessionHandler) Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error) {
+	if args.Expression == "progress" {
+		s := SessionFromContext(ctx)
+		pctx, err := s.StartProgress(ctx, &protocol.ProgressStartEventBody{ProgressId: "p1", Title: "evaluating", Cancellable: true})
+		if err != nil {
+			return nil, err
+		}
+		defer s.EndProgress(&protocol.ProgressEndEventBody{ProgressId: "p1"})
+		ctx = pctx
+	}
+	h.started <- args.Expression
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestHandlerPanic(t *testing.T) {
+	h := &policyHandler{
+		hooks: map[string]func(){
+			"evaluate boom": func() { panic("boom") },
+		},
+	}
+	var errorLog syncBuffer
+	s := NewSession(h)
+	s.ErrorLog = log.New(&errorLog, "", 0)
+	c := startSession(t, s)
+	defer c.close()
+
+	seq := c.send("evaluate", &protocol.EvaluateArguments{Expression: "boom"})
+	if msg := c.failure(seq); msg != "internal error: boom" {
+		t.Errorf("got error %q, want the panic", msg)
+	}
+	logged := errorLog.String()
+	if !strings.Contains(logged, `panic handling "evaluate" request 3: boom`) || !strings.Contains(logged, "policyHandler).Evaluate") {
+		t.Errorf("logged %q, want the panic with its stack trace", logged)
+	}
+
+	// The session keeps serving.
+	c.wait(c.send("evaluate", &protocol.EvaluateArguments{Expression: "x"}))
+}
+
+// limitedStream is a Stream whose Reader has a small MaxMessageSize.
+type limitedStream struct {
+	*transport.Reader
+	*transport.Writer
+	io.Closer
+}
+
+func TestMessageTooLarge(t *testing.T) {
+	c1, c2 := net.Pipe()
+	r := transport.NewReader(c1)
+	r.MaxMessageSize = 1000
+	var errorLog syncBuffer
+	s := NewSession(new(policyHandler))
+	s.ErrorLog = log.New(&errorLog, "", 0)
+	c := connect(t, s, &limitedStream{Reader: r, Writer: transport.NewWriter(c1), Closer: c1}, transport.NewStream(c2))
+	c.handshake()
+	defer c.close()
+
+	large := strings.Repeat("x", 5000)
+
+	// The head of the message holds its sequence number, so the request is replied with an error response.
+	c.seq++
+	data := `{"seq":` + strconv.Itoa(c.seq) + `,"type":"request","command":"evaluate","arguments":{"expression":"` + large + `"}}`
+	if err := c.stream.WriteMessage([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if msg := c.failure(c.seq); !strings.Contains(msg, "message too large") {
+		t.Errorf("got error %q, want message too large", msg)
+	}
+
+	// The sequence number follows the arguments beyond the head, so the request cannot be replied and is logged.
+	c.send("evaluate", &protocol.EvaluateArguments{Expression: large})
+	c.wait(c.send("evaluate", &protocol.EvaluateArguments{Expression: "x"}))
+	if logged := errorLog.String(); !strings.Contains(logged, "dropped message: transport: message too large") {
+		t.Errorf("logged %q, want the dropped message", logged)
+	}
+
+	// The reverse request of a response too large fails.
+	errc := make(chan error, 1)
+	go func() {
+		_, err := s.RunInTerminal(context.Background(), &protocol.RunInTerminalRequestArguments{Args: []string{"go"}})
+		errc <- err
+	}()
+	req := c.request()
+	c.seq++
+	data = `{"seq":` + strconv.Itoa(c.seq) + `,"type":"response","request_seq":` + strconv.Itoa(req.Seq) +
+		`,"command":"runInTerminal","success":true,"body":{"processId":1,"padding":"` + large + `"}}`
+	if err := c.stream.WriteMessage([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; !errors.Is(err, transport.ErrMessageTooLarge) {
+		t.Errorf("got %v for the response too large, want ErrMessageTooLarge", err)
+	}
+}
+
+func TestSendRequest(t *testing.T) {
+	s := NewSession(newSessionHandler())
+	c := startSession(t, s)
+	defer c.close()
+
+	type result struct {
+		cwd  string
+		body *protocol.RunInTerminalResponseBody
+		err  error
+	}
+	results := make(chan result, 2)
+	for _, cwd := range []string{"a", "b"} {
+		go func(cwd string) {
+			body, err := s.RunInTerminal(context.Background(), &protocol.RunInTerminalRequestArguments{Cwd: cwd, Args: []string{"go"}})
+			results <- result{cwd, body, err}
+		}(cwd)
+	}
+
+	// Each request is replied with its own process ID, in the reverse order.
+	reqs := []*protocol.Request{c.request(), c.request()}
+	for i := len(reqs) - 1; i >= 0; i-- {
+		var args protocol.RunInTerminalRequestArguments
+		if err := json.Unmarshal(reqs[i].Arguments, &args); err != nil {
+			t.Fatal(err)
+		}
+		c.reply(reqs[i], &protocol.RunInTerminalResponseBody{ProcessId: int(args.Cwd[0])})
+	}
+	for i := 0; i < 2; i++ {
+		r := <-results
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.body.ProcessId != int(r.cwd[0]) {
+			t.Errorf("got process %d for the request in %q, want %d", r.body.ProcessId, r.cwd, int(r.cwd[0]))
+		}
+	}
+
+	// An error response is returned as an *Error.
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.StartDebugging(context.Background(), &protocol.StartDebuggingRequestArguments{Request: "launch"})
+	}()
+	req := c.request()
+	c.seq++
+	c.write(map[string]interface{}{
+		"seq": c.seq, "type": "response", "request_seq": req.Seq, "command": req.Command, "success": false,
+		"message": "unsupported", "body": map[string]interface{}{"error": map[string]interface{}{"id": 1, "format": "cannot start {what}", "variables": map[string]string{"what": "debugging"}}},
+	})
+	var e *Error
+	if err := <-errc; !errors.As(err, &e) || e.Format != "cannot start {what}" || e.Variables["what"] != "debugging" {
+		t.Errorf("got %v, want the *Error of the response", err)
+	}
+}
+
+func TestSendRequestContext(t *testing.T) {
+	s := NewSession(newSessionHandler())
+	c := startSession(t, s)
+	defer c.close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := s.RunInTerminal(ctx, &protocol.RunInTerminalRequestArguments{Args: []string{"go"}}); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	s.mu.Lock()
+	pending := len(s.pending)
+	s.mu.Unlock()
+	if pending != 0 {
+		t.Errorf("%d reverse requests left pending", pending)
+	}
+
+	// The late response is ignored.
+	c.reply(c.request(), &protocol.RunInTerminalResponseBody{ProcessId: 1})
+	c.wait(c.send("threads", nil))
+}
+
+func TestSendRequestDisconnected(t *testing.T) {
+	s := NewSession(newSessionHandler())
+	c := startSession(t, s)
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := s.RunInTerminal(context.Background(), &protocol.RunInTerminalRequestArguments{Args: []string{"go"}})
+		errc <- err
+	}()
+	c.request()
+	c.close()
+	if err := <-errc; err != ErrDisconnected {
+		t.Errorf("got %v for the request pending, want ErrDisconnected", err)
+	}
+	if _, err := s.RunInTerminal(context.Background(), &protocol.RunInTerminalRequestArguments{Args: []string{"go"}}); err != ErrNotServing {
+		t.Errorf("got %v after the session ended, want ErrNotServing", err)
+	}
+}
+
+func TestCancelRequest(t *testing.T) {
+	h := newSessionHandler()
+	c := startSession(t, NewSession(h))
+	defer c.close()
+
+	seq := c.send("evaluate", &protocol.EvaluateArguments{Expression: "x"})
+	<-h.started
+	cancel := c.send("cancel", &protocol.CancelArguments{RequestId: seq})
+	resps := c.collect(seq, cancel)
+	if !resps[cancel].Success {
+		t.Errorf("cancel request failed: %s", resps[cancel].Message)
+	}
+	if resp := resps[seq]; resp.Success || resp.Message != protocol.ResponseErrorCancelled {
+		t.Errorf("got %+v for the request cancelled, want a 'cancelled' error response", resp)
+	}
+}
+
+func TestCancelProgress(t *testing.T) {
+	h := newSessionHandler()
+	c := startSession(t, NewSession(h))
+	defer c.close()
+
+	seq := c.send("evaluate", &protocol.EvaluateArguments{Expression: "progress"})
+	c.event("progressStart")
+	<-h.started
+	cancel := c.send("cancel", &protocol.CancelArguments{ProgressId: "p1"})
+	resps := c.collect(seq, cancel)
+	if !resps[cancel].Success {
+		t.Errorf("cancel request failed: %s", resps[cancel].Message)
+	}
+	if resp := resps[seq]; resp.Success || resp.Message != protocol.ResponseErrorCancelled {
+		t.Errorf("got %+v for the request whose progress is cancelled, want a 'cancelled' error response", resp)
+	}
+	c.event("progressEnd")
+}
+
+func TestCancelPending(t *testing.T) {
+	release := make(chan struct{})
+	h := newSessionHandler()
+	h.hooks = map[string]func(){
+		"next 1": func() { <-release },
+	}
+	c := startSession(t, NewSession(h))
+	defer c.close()
+
+	next := c.send("next", &protocol.NextArguments{ThreadId: 1})
+	threads := c.send("threads", nil)
+	c.wait(c.send("cancel", &protocol.CancelArguments{RequestId: threads}))
+	close(release)
+
+	// The request cancelled while waiting for the one before is replied without being handled.
+	resps := c.collect(next, threads)
+	if !resps[next].Success {
+		t.Errorf("next request failed: %s", resps[next].Message)
+	}
+	if resp := resps[threads]; resp.Success || resp.Message != protocol.ResponseErrorCancelled {
+		t.Errorf("got %+v for the request cancelled, want a 'cancelled' error response", resp)
+	}
+	if calls, _ := h.recorded(); !reflect.DeepEqual(calls, []string{"next 1"}) {
+		t.Errorf("handled %v, want only the request not cancelled", calls)
+	}
+}
+
+func TestOutOfOrder(t *testing.T) {
+	h := newSessionHandler()
+	s := NewSession(h)
+	a, b := transport.Pipe()
+	c := connect(t, s, a, b)
+	defer c.close()
+
+	tests := []struct {
+		command string
+		args    interface{}
+		err     string // the error of the response, or "" if the request succeeds
+		state   State
+	}{
+		{"threads", nil, "'threads' request before 'initialize'", StateUninitialized},
+		{"configurationDone", nil, "'configurationDone' request before 'initialize'", StateUninitialized},
+		{"initialize", &protocol.InitializeRequestArguments{AdapterID: "test"}, "", StateConfiguring},
+		{"initialize", &protocol.InitializeRequestArguments{AdapterID: "test"}, "'initialize' request in configuring state", StateConfiguring},
+		{"next", &protocol.NextArguments{ThreadId: 1}, "'next' request before 'configurationDone'", StateConfiguring},
+		{"threads", nil, "", StateConfiguring},
+		{"configurationDone", nil, "", StateRunning},
+		{"configurationDone", nil, "'configurationDone' request in running state", StateRunning},
+		{"next", &protocol.NextArguments{ThreadId: 1}, "", StateRunning},
+	}
+	for _, tt := range tests {
+		seq := c.send(tt.command, tt.args)
+		resp := c.collect(seq)[seq]
+		switch {
+		case tt.err == "" && !resp.Success:
+			t.Errorf("%s request failed: %s", tt.command, resp.Message)
+		case tt.err != "" && string(resp.Message) != tt.err:
+			t.Errorf("%s request: got %q, want %q", tt.command, resp.Message, tt.err)
+		}
+		if state := s.State(); state != tt.state {
+			t.Errorf("after the %s request: state %v, want %v", tt.command, state, tt.state)
+		}
+	}
+
+	// The requests rejected are not handled.
+	if calls, _ := h.recorded(); !reflect.DeepEqual(calls, []string{"threads", "next 1"}) {
+		t.Errorf("handled %v, want the requests accepted", calls)
+	}
+}
+
+func TestInitializedEvent(t *testing.T) {
+	tests := []struct {
+		name            string
+		sendInitialized bool
+	}{
+		{"sent by the session", false},
+		{"sent by the handler", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			h := newSessionHandler()
+			h.sendInitialized = tt.sendInitialized
+			c := startSession(t, NewSession(h))
+			c.wait(c.send("threads", nil))
+			c.close()
+
+			// The event sent while handling 'initialize' is held until its response.
+			want := []string{"response initialize", "event initialized", "response configurationDone", "response threads"}
+			if got := c.messages(); !reflect.DeepEqual(got, want) {
+				t.Errorf("received %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDeferInitialized(t *testing.T) {
+	s := NewSession(newSessionHandler())
+	s.DeferInitialized = true
+	a, b := transport.Pipe()
+	c := connect(t, s, a, b)
+	defer c.close()
+
+	c.wait(c.send("initialize", &protocol.InitializeRequestArguments{AdapterID: "test"}))
+	c.wait(c.send("threads", nil))
+	if got, want := c.messages(), []string{"response initialize", "response threads"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("received %v, want %v without the 'initialized' event", got, want)
+	}
+	if err := s.SendEvent(new(protocol.InitializedEvent)); err != nil {
+		t.Fatal(err)
+	}
+	c.event("initialized")
+	c.wait(c.send("configurationDone", nil))
+}
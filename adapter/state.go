@@ -0,0 +1,184 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package adapter
+
+import (
+	"fmt"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// State is the stage of the lifecycle of a debug session.
+//
+// The session goes through the states in order:
+// the client initializes the debug adapter, configures it, and the debuggee runs until it terminates
+// and the client disconnects.
+type State int
+
+const (
+	// StateUninitialized is the state before the 'initialize' request.
+	// Only the 'initialize' request is accepted.
+	StateUninitialized State = iota
+
+	// StateInitializing is the state while the 'initialize' request is handled.
+	StateInitializing
+
+	// StateConfiguring is the state after the response to the 'initialize' request,
+	// while the client sends the configuration requests such as 'setBreakpoints'.
+	// The requests controlling the execution are rejected.
+	StateConfiguring
+
+	// StateRunning is the state after the 'configurationDone' request,
+	// or after the 'launch' or 'attach' request if the debug adapter does not support 'configurationDone'.
+	StateRunning
+
+	// StateTerminated is the state after the 'terminated' event has been sent.
+	// The requests controlling the execution are rejected until the session is restarted.
+	StateTerminated
+
+	// StateDisconnected is the state after the 'disconnect' request or the end of the connection.
+	// All the requests are rejected.
+	StateDisconnected
+)
+
+var stateNames = [...]string{
+	StateUninitialized: "uninitialized",
+	StateInitializing:  "initializing",
+	StateConfiguring:   "configuring",
+	StateRunning:       "running",
+	StateTerminated:    "terminated",
+	StateDisconnected:  "disconnected",
+}
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	if s < 0 || int(s) >= len(stateNames) {
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+	return stateNames[s]
+}
+
+// executionRequests is the set of the commands of the requests controlling the execution of the debuggee.
+var executionRequests = map[string]bool{
+	"continue":        true,
+	"goto":            true,
+	"next":            true,
+	"pause":           true,
+	"restartFrame":    true,
+	"reverseContinue": true,
+	"stepBack":        true,
+	"stepIn":          true,
+	"stepOut":         true,
+}
+
+// State returns the current state of the session.
+func (s *Session) State() State {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	return s.state
+}
+
+// Capabilities returns the capabilities of the debug adapter returned by the 'initialize' request,
+// or nil before the response to the request.
+func (s *Session) Capabilities() *protocol.Capabilities {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	return s.capabilities
+}
+
+// enter checks that the request of command is allowed in the current state
+// and moves to StateInitializing for the 'initialize' request.
+func (s *Session) enter(command string) error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	switch {
+	case s.state == StateDisconnected:
+		return fmt.Errorf("'%s' request after 'disconnect'", command)
+	case command == "initialize":
+		if s.state != StateUninitialized {
+			return fmt.Errorf("'initialize' request in %s state", s.state)
+		}
+		s.state = StateInitializing
+	case s.state == StateUninitialized:
+		return fmt.Errorf("'%s' request before 'initialize'", command)
+	case s.state == StateInitializing:
+		return fmt.Errorf("'%s' request before the response to 'initialize'", command)
+	case command == "configurationDone" && s.state != StateConfiguring:
+		return fmt.Errorf("'configurationDone' request in %s state", s.state)
+	case executionRequests[command] && s.state == StateConfiguring:
+		return fmt.Errorf("'%s' request before 'configurationDone'", command)
+	case executionRequests[command] && s.state == StateTerminated:
+		return fmt.Errorf("'%s' request after the debuggee terminated", command)
+	}
+	return nil
+}
+
+// leave moves to the state following the request of command whose response is m,
+// or which failed if m is nil.
+func (s *Session) leave(command string, m protocol.ResponseMessage) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if m == nil {
+		if command == "initialize" {
+			s.state = StateUninitialized
+		}
+		return
+	}
+	switch command {
+	case "initialize":
+		s.capabilities = new(protocol.Capabilities)
+		if r, ok := m.(*protocol.InitializeResponse); ok && r.Body != nil {
+			s.capabilities = r.Body
+		}
+		s.state = StateConfiguring
+	case "configurationDone", "restart":
+		s.state = StateRunning
+	case "launch", "attach":
+		if !s.capabilities.SupportsConfigurationDoneRequest {
+			s.state = StateRunning
+		}
+	case "disconnect":
+		s.state = StateDisconnected
+	}
+}
+
+// terminated moves to StateTerminated.
+func (s *Session) terminated() {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.state != StateDisconnected {
+		s.state = StateTerminated
+	}
+}
+
+// disconnected moves to StateDisconnected.
+func (s *Session) disconnected() {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	s.state = StateDisconnected
+}
+
+// initializeDone sends the 'initialized' event after the response to the 'initialize' request,
+// which succeeded if ok is true: the event held since the Handler sent it,
+// or a new one unless the Handler sent it already or DeferInitialized is set.
+// If the request failed, the event held is dropped.
+func (s *Session) initializeDone(ok bool) {
+	s.stateMu.Lock()
+	event := s.initialized
+	s.initialized = nil
+	if !ok {
+		s.initializedSent = false
+		s.stateMu.Unlock()
+		return
+	}
+	s.initializeReplied = true
+	if event == nil && !s.initializedSent && !s.DeferInitialized {
+		event = new(protocol.InitializedEvent)
+	}
+	s.stateMu.Unlock()
+	if event != nil {
+		_ = s.SendEvent(event)
+	}
+}
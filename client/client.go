@@ -0,0 +1,505 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package client implements a client of the Debug Adapter Protocol driving a debug adapter.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"regexp"
+	"sync"
+
+	"github.com/go-language-server/dap/protocol"
+	"github.com/go-language-server/dap/transport"
+)
+
+// ErrClosed is returned when sending a request by a Client whose connection to the debug adapter is closed,
+// and by the requests waiting for their response when the connection is closed.
+var ErrClosed = errors.New("client: connection closed")
+
+// ErrNotSupported is returned by the methods of UnsupportedReverseHandler.
+// The debug adapter receives an error response to the reverse request.
+var ErrNotSupported = errors.New("not supported")
+
+// Error is an error response of the debug adapter to a request.
+type Error struct {
+	// Command is the command of the request.
+	Command string
+
+	// Message is the raw error in short form, such as 'cancelled'.
+	Message string
+
+	// Details is the structured error message of the response if any.
+	Details *protocol.ErrorMessage
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	msg := e.Message
+	if e.Details != nil && e.Details.Format != "" {
+		msg = formatMessage(e.Details)
+	}
+	return fmt.Sprintf("%s: %s", e.Command, msg)
+}
+
+var variablePattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// formatMessage returns the format of m with the variables in curly braces replaced by their value.
+func formatMessage(m *protocol.ErrorMessage) string {
+	return variablePattern.ReplaceAllStringFunc(m.Format, func(v string) string {
+		if value, ok := m.Variables[v[1:len(v)-1]]; ok {
+			return value
+		}
+		return v
+	})
+}
+
+// unexpectedResponse returns the error of a response whose type does not match its request.
+func unexpectedResponse(resp protocol.ResponseMessage) error {
+	return fmt.Errorf("client: unexpected %T to %q request", resp, resp.GetCommand())
+}
+
+// ReverseHandler handles the reverse requests sent by the debug adapter to the client.
+//
+// An error returned by a method is sent to the debug adapter as an error response.
+// Embed UnsupportedReverseHandler in the implementations to reply with an error response
+// to the requests they do not support.
+type ReverseHandler interface {
+	// RunInTerminal handles the 'runInTerminal' request, see protocol.RunInTerminalRequest.
+	RunInTerminal(ctx context.Context, args *protocol.RunInTerminalRequestArguments) (*protocol.RunInTerminalResponseBody, error)
+
+	// StartDebugging handles the 'startDebugging' request, see protocol.StartDebuggingRequest.
+	StartDebugging(ctx context.Context, args *protocol.StartDebuggingRequestArguments) error
+}
+
+// UnsupportedReverseHandler is a ReverseHandler which does not support any request.
+// Its methods return ErrNotSupported.
+type UnsupportedReverseHandler struct{}
+
+// RunInTerminal implements ReverseHandler.
+func (UnsupportedReverseHandler) RunInTerminal(ctx context.Context, args *protocol.RunInTerminalRequestArguments) (*protocol.RunInTerminalResponseBody, error) {
+	return nil, ErrNotSupported
+}
+
+// StartDebugging implements ReverseHandler.
+func (UnsupportedReverseHandler) StartDebugging(ctx context.Context, args *protocol.StartDebuggingRequestArguments) error {
+	return ErrNotSupported
+}
+
+// Client is a client connected to a debug adapter.
+//
+// It has a method per request sending the request and returning the body of its response,
+// such as SetBreakpoints. The events are passed to the functions registered by OnEvent,
+// and the reverse requests to the ReverseHandler.
+//
+// A response exceeding the maximum message size of the stream fails its request.
+// The connection is closed if such a message cannot be identified from its head,
+// as it may be the response to a request.
+//
+// The methods of Client are safe for concurrent use.
+type Client struct {
+	stream  transport.Stream
+	out     *protocol.SeqWriter
+	reverse ReverseHandler
+	cmd     *exec.Cmd
+
+	ctx    context.Context // passed to the ReverseHandler, cancelled by Close
+	cancel context.CancelFunc
+
+	mu       sync.Mutex // guards pending, closed, handlers, nextID and watchers
+	pending  map[int]chan reply
+	closed   bool
+	handlers map[int]func(protocol.EventMessage)
+	nextID   int
+	watchers []*watcher
+
+	events *eventQueue
+	wg     sync.WaitGroup
+	done   chan struct{} // closed when the connection is closed
+}
+
+// New returns a new Client talking to the debug adapter connected by stream.
+// The reverse requests are handled by h, or replied with an error response if h is nil.
+func New(stream transport.Stream, h ReverseHandler) *Client {
+	if h == nil {
+		h = UnsupportedReverseHandler{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Client{
+		stream:   stream,
+		out:      protocol.NewSeqWriter(stream),
+		reverse:  h,
+		ctx:      ctx,
+		cancel:   cancel,
+		pending:  make(map[int]chan reply),
+		handlers: make(map[int]func(protocol.EventMessage)),
+		events:   newEventQueue(),
+		done:     make(chan struct{}),
+	}
+	c.wg.Add(2)
+	go c.read()
+	go c.dispatchEvents()
+	return c
+}
+
+// Dial connects to the debug adapter serving on the network address, such as ("tcp", "localhost:4711").
+func Dial(ctx context.Context, network, address string, h ReverseHandler) (*Client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	return New(transport.NewStream(conn), h), nil
+}
+
+// Exec starts the debug adapter cmd and talks to it over its standard input and output.
+// Close waits for the process to exit after closing its standard input.
+func Exec(cmd *exec.Cmd, h ReverseHandler) (*Client, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	c := New(transport.NewStream(&pipe{Reader: stdout, WriteCloser: stdin}), h)
+	c.cmd = cmd
+	return c, nil
+}
+
+// pipe is the standard output and input of a process.
+type pipe struct {
+	io.Reader
+	io.WriteCloser
+}
+
+// Close closes the connection to the debug adapter and waits for the events to be dispatched
+// and the reverse requests to be handled, whose context is cancelled.
+// The requests waiting for their response fail with ErrClosed.
+func (c *Client) Close() error {
+	err := c.stream.Close()
+	c.cancel()
+	c.wg.Wait()
+	if c.cmd != nil {
+		if werr := c.cmd.Wait(); err == nil {
+			err = werr
+		}
+	}
+	return err
+}
+
+// Done returns a channel closed when the connection to the debug adapter is closed.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Send sends the request req and waits for its response.
+//
+// The type, command and sequence number of req are set by Send.
+// If the debug adapter replies with an error response, Send returns it along with an *Error describing it.
+// Send returns the error of ctx if ctx is done before the response is received.
+func (c *Client) Send(ctx context.Context, req protocol.RequestMessage) (protocol.ResponseMessage, error) {
+	ch := make(chan reply, 1)
+	err := c.out.WriteFunc(req, func(seq int) error {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.closed {
+			return ErrClosed
+		}
+		c.pending[seq] = ch
+		return nil
+	})
+	switch {
+	case err == ErrClosed:
+		return nil, err
+	case err != nil:
+		c.mu.Lock()
+		delete(c.pending, req.GetSeq())
+		c.mu.Unlock()
+		return nil, fmt.Errorf("client: %w", err)
+	}
+
+	select {
+	case r, ok := <-ch:
+		if !ok {
+			return nil, ErrClosed
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		resp := r.resp
+		if !resp.GetSuccess() {
+			return resp, responseError(resp)
+		}
+		return resp, nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.pending, req.GetSeq())
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// reply is the response to a request, or the error of the response which could not be decoded.
+type reply struct {
+	resp protocol.ResponseMessage
+	err  error
+}
+
+// responseError returns the *Error describing the error response resp.
+func responseError(resp protocol.ResponseMessage) *Error {
+	e := &Error{
+		Command: resp.GetCommand(),
+		Message: resp.GetMessage(),
+	}
+	if r, ok := resp.(*protocol.ErrorResponse); ok && r.Body != nil {
+		e.Details = r.Body.Error
+	}
+	return e
+}
+
+// OnEvent registers f to be called with each event received from the debug adapter
+// and returns the function unregistering it.
+//
+// The functions are called one at a time in the order the events are received, from a goroutine
+// which does not read the messages, so they may send requests. A type switch gives the concrete event:
+//
+//	c.OnEvent(func(e protocol.EventMessage) {
+//		switch e := e.(type) {
+//		case *protocol.StoppedEvent:
+//			log.Printf("thread %d stopped: %s", e.Body.ThreadId, e.Body.Reason)
+//		case *protocol.OutputEvent:
+//			fmt.Print(e.Body.Output)
+//		}
+//	})
+func (c *Client) OnEvent(f func(protocol.EventMessage)) (unregister func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := c.nextID
+	c.handlers[id] = f
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.handlers, id)
+	}
+}
+
+// read reads the messages from the debug adapter until the connection is closed.
+func (c *Client) read() {
+	defer c.wg.Done()
+	defer c.closeConn()
+	for {
+		data, err := c.stream.ReadMessage()
+		var tooLarge *transport.MessageTooLargeError
+		switch {
+		case errors.As(err, &tooLarge):
+			// The head of the message usually identifies it. Otherwise the message may be the response
+			// to a request, which would never complete, so the connection is closed.
+			if !c.decodeError(tooLarge.Head, err) {
+				c.stream.Close()
+				return
+			}
+			continue
+		case err != nil:
+			return
+		}
+
+		msg, err := protocol.DecodeMessage(data)
+		if err != nil {
+			c.decodeError(data, err)
+			continue
+		}
+		switch m := msg.(type) {
+		case protocol.ResponseMessage:
+			c.complete(m.GetRequestSeq(), reply{resp: m})
+		case protocol.EventMessage:
+			c.notify(m)
+			c.events.push(m)
+		case protocol.RequestMessage:
+			c.wg.Add(1)
+			go func() {
+				defer c.wg.Done()
+				c.handleReverse(m)
+			}()
+		}
+	}
+}
+
+// decodeError handles the message data which could not be read or decoded because of err.
+// The request of a response fails with err and a reverse request is replied with an error response.
+// The events are dropped. It returns false if data is not identified as a message.
+func (c *Client) decodeError(data []byte, err error) bool {
+	h, herr := protocol.DecodeMessageHeader(data)
+	switch {
+	case herr != nil:
+		return false
+	case h.Type == protocol.ProtocolMessageTypeResponse && h.RequestSeq != 0:
+		c.complete(h.RequestSeq, reply{err: fmt.Errorf("client: %w", err)})
+	case h.Type == protocol.ProtocolMessageTypeRequest && h.Seq != 0:
+		req := &protocol.Request{ProtocolMessage: h.ProtocolMessage, Command: h.Command}
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.replyError(req, err)
+		}()
+	case h.Type != protocol.ProtocolMessageTypeEvent:
+		return false
+	}
+	return true
+}
+
+// complete passes r to the request waiting for the response to the request seq, if any.
+func (c *Client) complete(seq int, r reply) {
+	c.mu.Lock()
+	ch, ok := c.pending[seq]
+	delete(c.pending, seq)
+	c.mu.Unlock()
+	if ok {
+		ch <- r
+	}
+}
+
+// closeConn fails the requests waiting for their response and stops dispatching the events.
+func (c *Client) closeConn() {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = nil
+	c.closed = true
+	c.mu.Unlock()
+	for _, ch := range pending {
+		close(ch)
+	}
+	c.events.close()
+	close(c.done)
+}
+
+// dispatchEvents passes the events to the functions registered by OnEvent.
+func (c *Client) dispatchEvents() {
+	defer c.wg.Done()
+	for {
+		event, ok := c.events.pop()
+		if !ok {
+			return
+		}
+		c.mu.Lock()
+		handlers := make([]func(protocol.EventMessage), 0, len(c.handlers))
+		for id := 1; id <= c.nextID; id++ {
+			if f, ok := c.handlers[id]; ok {
+				handlers = append(handlers, f)
+			}
+		}
+		c.mu.Unlock()
+		for _, f := range handlers {
+			f(event)
+		}
+	}
+}
+
+// handleReverse calls the ReverseHandler method for the reverse request req and sends its response.
+func (c *Client) handleReverse(req protocol.RequestMessage) {
+	resp := newResponse(req)
+
+	var (
+		m   protocol.ResponseMessage
+		err error
+	)
+	switch req := req.(type) {
+	case *protocol.RunInTerminalRequest:
+		var body *protocol.RunInTerminalResponseBody
+		if body, err = c.reverse.RunInTerminal(c.ctx, req.Arguments); err == nil {
+			if body == nil {
+				body = new(protocol.RunInTerminalResponseBody)
+			}
+			m = &protocol.RunInTerminalResponse{Response: resp, Body: body}
+		}
+	case *protocol.StartDebuggingRequest:
+		if err = c.reverse.StartDebugging(c.ctx, req.Arguments); err == nil {
+			m = &protocol.StartDebuggingResponse{Response: resp}
+		}
+	default:
+		err = fmt.Errorf("unknown command %q", req.GetCommand())
+	}
+
+	if err != nil {
+		c.replyError(req, err)
+		return
+	}
+	// The write error means the connection is lost, which the reader finds out as well.
+	_ = c.out.Write(m)
+}
+
+// replyError sends the error response to the reverse request req describing err.
+func (c *Client) replyError(req protocol.RequestMessage, err error) {
+	resp := newResponse(req)
+	resp.Success = false
+	resp.Message = protocol.ResponseError(err.Error())
+	_ = c.out.Write(&protocol.ErrorResponse{Response: resp, Body: new(protocol.ErrorResponseBody)})
+}
+
+// newResponse returns the successful response to req.
+func newResponse(req protocol.RequestMessage) protocol.Response {
+	return protocol.Response{
+		ProtocolMessage: protocol.ProtocolMessage{
+			Type: protocol.ProtocolMessageTypeResponse,
+		},
+		Command:    req.GetCommand(),
+		RequestSeq: req.GetSeq(),
+		Success:    true,
+	}
+}
+
+// eventQueue is an unbounded queue of events, so that reading the messages never waits for the events to be handled.
+type eventQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	events []protocol.EventMessage
+	closed bool
+}
+
+func newEventQueue() *eventQueue {
+	q := new(eventQueue)
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *eventQueue) push(event protocol.EventMessage) {
+	q.mu.Lock()
+	q.events = append(q.events, event)
+	q.mu.Unlock()
+	q.cond.Signal()
+}
+
+// pop returns the next event, waiting for it if the queue is empty.
+// It returns false once the queue is closed and empty.
+func (q *eventQueue) pop() (protocol.EventMessage, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.events) == 0 {
+		if q.closed {
+			return nil, false
+		}
+		q.cond.Wait()
+	}
+	event := q.events[0]
+	q.events[0] = nil
+	q.events = q.events[1:]
+	return event, true
+}
+
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.cond.Broadcast()
+}
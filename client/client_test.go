@@ -0,0 +1,420 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-language-server/dap/adapter"
+	"github.com/go-language-server/dap/protocol"
+	"github.com/go-language-server/dap/transport"
+)
+
+// debugHandler is a debug adapter whose debuggee stops on 'continue' and exits on 'next',
+// sending the event before the response to the request. It records the commands of the requests handled.
+type debugHandler struct {
+	adapter.UnimplementedHandler
+
+	mu       sync.Mutex
+	commands []string
+}
+
+func (h *debugHandler) record(command string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.commands = append(h.commands, command)
+}
+
+func (h *debugHandler) handled() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.commands...)
+}
+
+func (h *debugHandler) Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error) {
+	h.record("initialize")
+	return &protocol.Capabilities{SupportsConfigurationDoneRequest: true}, nil
+}
+
+func (h *debugHandler) Launch(ctx context.Context, args *protocol.LaunchRequestArguments) error {
+	h.record("launch")
+	return nil
+}
+
+func (h *debugHandler) SetBreakpoints(ctx context.Context, args *protocol.SetBreakpointsArguments) (*protocol.SetBreakpointsResponseBody, error) {
+	h.record("setBreakpoints")
+	body := new(protocol.SetBreakpointsResponseBody)
+	for _, b := range args.Breakpoints {
+		body.Breakpoints = append(body.Breakpoints, &protocol.Breakpoint{Verified: true, Line: b.Line})
+	}
+	return body, nil
+}
+
+func (h *debugHandler) ConfigurationDone(ctx context.Context, args *protocol.ConfigurationDoneArguments) error {
+	h.record("configurationDone")
+	return nil
+}
+
+func (h *debugHandler) Continue(ctx context.Context, args *protocol.ContinueArguments) (*protocol.ContinueResponseBody, error) {
+	h.record("continue")
+	err := adapter.SessionFromContext(ctx).SendEvent(&protocol.StoppedEvent{
+		Body: &protocol.StoppedEventBody{Reason: protocol.StoppedEventReasonBreakpoint, ThreadId: args.ThreadId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return new(protocol.ContinueResponseBody), nil
+}
+
+func (h *debugHandler) Next(ctx context.Context, args *protocol.NextArguments) error {
+	h.record("next")
+	return adapter.SessionFromContext(ctx).SendEvent(&protocol.ExitedEvent{Body: &protocol.ExitedEventBody{ExitCode: 3}})
+}
+
+// Evaluate returns the expression as its result. The expression "terminal" sends the 'runInTerminal' request
+// and returns its error, and "wait" waits for the request to be cancelled.
+func (h *debugHandler) Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error) {
+	h.record("evaluate")
+	switch args.Expression {
+	case "terminal":
+		result := "ok"
+		_, err := adapter.SessionFromContext(ctx).RunInTerminal(ctx, &protocol.RunInTerminalRequestArguments{Args: []string{"go"}})
+		if err != nil {
+			result = err.Error()
+		}
+		return &protocol.EvaluateResponseBody{Result: result}, nil
+	case "wait":
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return &protocol.EvaluateResponseBody{Result: args.Expression}, nil
+}
+
+// adapterStream is the end of the connection served by the debug adapter.
+// The messages it writes are decoded as JSON values and passed to rewrite, then to written once written.
+type adapterStream struct {
+	transport.Stream
+
+	// rewrite changes the message m and returns true to write it instead of the original one.
+	rewrite func(m map[string]interface{}) bool
+
+	// written is called with each message written.
+	written func(m map[string]interface{})
+}
+
+func (s *adapterStream) WriteMessage(data []byte) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	if s.rewrite != nil && s.rewrite(m) {
+		var err error
+		if data, err = json.Marshal(m); err != nil {
+			return err
+		}
+	}
+	if err := s.Stream.WriteMessage(data); err != nil {
+		return err
+	}
+	if s.written != nil {
+		s.written(m)
+	}
+	return nil
+}
+
+// connect serves the client end b of the connection with s, which serves the end a,
+// and returns the Client talking to s and the function closing the connection.
+func connect(t *testing.T, s *adapter.Session, a, b transport.Stream) (*Client, func()) {
+	t.Helper()
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		if err := s.ServeStream(context.Background(), a); err != nil {
+			t.Error(err)
+		}
+	}()
+	c := New(b, nil)
+	return c, func() {
+		if err := c.Close(); err != nil {
+			t.Error(err)
+		}
+		select {
+		case <-served:
+		case <-time.After(5 * time.Second):
+			t.Error("session still serving after the client closed the connection")
+		}
+	}
+}
+
+// start serves a Client with a new Session of h over a pipe.
+// The messages of the Session pass through out, whose Stream is set by start.
+func start(t *testing.T, h adapter.Handler, out *adapterStream) (*Client, func()) {
+	t.Helper()
+	a, b := transport.Pipe()
+	out.Stream = a
+	return connect(t, adapter.NewSession(h), out, b)
+}
+
+// testContext returns the context of a test which does not wait forever.
+func testContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 5*time.Second)
+}
+
+// isResponse reports whether m is the response to the request of command.
+func isResponse(m map[string]interface{}, command string) bool {
+	return m["type"] == "response" && m["command"] == command
+}
+
+func TestStartLaunch(t *testing.T) {
+	cfg := &Config{
+		Initialize: &protocol.InitializeRequestArguments{AdapterID: "test"},
+		Breakpoints: []*protocol.SetBreakpointsArguments{{
+			Source:      &protocol.Source{Path: "main.go"},
+			Breakpoints: []*protocol.SourceBreakpoint{{Line: 3}, {Line: 7}},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		// deferInitialized makes the session send the 'initialized' event after the response to 'launch'
+		// instead of after the response to 'initialize'.
+		deferInitialized bool
+	}{
+		{name: "initialized before launch response"},
+		{name: "initialized after launch response", deferInitialized: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			h := new(debugHandler)
+			s := adapter.NewSession(h)
+			out := new(adapterStream)
+			if tt.deferInitialized {
+				s.DeferInitialized = true
+				out.written = func(m map[string]interface{}) {
+					if isResponse(m, "launch") {
+						// SendEvent writes to the stream, which is not reentrant.
+						// The delay gives the client the time to send the configuration requests too early.
+						go func() {
+							time.Sleep(20 * time.Millisecond)
+							h.record("initialized")
+							s.SendEvent(new(protocol.InitializedEvent))
+						}()
+					}
+				}
+			}
+			a, b := transport.Pipe()
+			out.Stream = a
+			c, closeConn := connect(t, s, out, b)
+			defer closeConn()
+
+			ctx, cancel := testContext()
+			defer cancel()
+			session, err := c.StartLaunch(ctx, cfg, &protocol.LaunchRequestArguments{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !session.Capabilities.SupportsConfigurationDoneRequest {
+				t.Errorf("got capabilities %+v, want those of the debug adapter", session.Capabilities)
+			}
+			want := [][]*protocol.Breakpoint{{{Verified: true, Line: 3}, {Verified: true, Line: 7}}}
+			if !reflect.DeepEqual(session.Breakpoints, want) {
+				t.Errorf("got breakpoints %+v, want %+v", session.Breakpoints, want)
+			}
+
+			// The configuration requests follow the 'initialized' event, which may come before 'launch' is handled.
+			commands := h.handled()
+			if tt.deferInitialized {
+				want := []string{"initialize", "launch", "initialized", "setBreakpoints", "configurationDone"}
+				if !reflect.DeepEqual(commands, want) {
+					t.Errorf("handled %v, want %v", commands, want)
+				}
+			} else {
+				var configuration []string
+				for _, command := range commands {
+					if command != "launch" {
+						configuration = append(configuration, command)
+					}
+				}
+				want := []string{"initialize", "setBreakpoints", "configurationDone"}
+				if len(commands) != 4 || !reflect.DeepEqual(configuration, want) {
+					t.Errorf("handled %v, want 'launch' and %v", commands, want)
+				}
+			}
+			if state := s.State(); state != adapter.StateRunning {
+				t.Errorf("session in %s state, want running", state)
+			}
+		})
+	}
+}
+
+func TestContinueUntilStopped(t *testing.T) {
+	c, closeConn := start(t, new(debugHandler), new(adapterStream))
+	defer closeConn()
+	ctx, cancel := testContext()
+	defer cancel()
+	if _, err := c.StartLaunch(ctx, &Config{Initialize: &protocol.InitializeRequestArguments{AdapterID: "test"}}, &protocol.LaunchRequestArguments{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The 'stopped' event is sent before the response to 'continue'.
+	body, err := c.ContinueUntilStopped(ctx, &protocol.ContinueArguments{ThreadId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body.Reason != protocol.StoppedEventReasonBreakpoint || body.ThreadId != 1 {
+		t.Errorf("got stopped event %+v, want thread 1 stopped on a breakpoint", body)
+	}
+
+	// The debuggee exits instead of stopping.
+	_, err = c.StepOverAndWait(ctx, &protocol.NextArguments{ThreadId: 1})
+	if !errors.Is(err, ErrTerminated) || !strings.Contains(err.Error(), "exit code 3") {
+		t.Errorf("got %v, want ErrTerminated with the exit code", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	out := &adapterStream{
+		rewrite: func(m map[string]interface{}) bool {
+			switch {
+			case isResponse(m, "evaluate"):
+				body := m["body"].(map[string]interface{})
+				if body["result"] != "invalid" {
+					return false
+				}
+				body["result"] = 1
+			case m["type"] == "request" && m["command"] == "runInTerminal":
+				m["arguments"].(map[string]interface{})["args"] = "go"
+			default:
+				return false
+			}
+			return true
+		},
+	}
+	c, closeConn := start(t, new(debugHandler), out)
+	defer closeConn()
+	ctx, cancel := testContext()
+	defer cancel()
+	if _, err := c.StartLaunch(ctx, &Config{Initialize: &protocol.InitializeRequestArguments{AdapterID: "test"}}, &protocol.LaunchRequestArguments{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The response which cannot be decoded fails its request.
+	_, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "invalid"})
+	var respErr *Error
+	if err == nil || errors.As(err, &respErr) {
+		t.Errorf("got %v, want the decoding error of the response", err)
+	}
+
+	// The reverse request which cannot be decoded is replied with an error response.
+	body, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "terminal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body.Result, "cannot unmarshal") {
+		t.Errorf("'runInTerminal' failed with %q, want the decoding error", body.Result)
+	}
+
+	// The client keeps working.
+	if body, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "x"}); err != nil || body.Result != "x" {
+		t.Errorf("got %+v, %v, want the result x", body, err)
+	}
+}
+
+// large is an expression whose response exceeds the maximum message size and the head kept of such a message.
+var large = strings.Repeat("x", 5000)
+
+// limitedStream is a Stream whose Reader has a small MaxMessageSize.
+type limitedStream struct {
+	*transport.Reader
+	*transport.Writer
+	io.Closer
+}
+
+func TestMessageTooLarge(t *testing.T) {
+	c1, c2 := net.Pipe()
+	r := transport.NewReader(c1)
+	r.MaxMessageSize = 1000
+	out := &adapterStream{
+		Stream: transport.NewStream(c2),
+		rewrite: func(m map[string]interface{}) bool {
+			// Encoding the response as a map sorts its properties, so that the body comes before its type.
+			return isResponse(m, "evaluate") && m["body"].(map[string]interface{})["result"] == "unidentified"+large
+		},
+	}
+	c, closeConn := connect(t, adapter.NewSession(new(debugHandler)), out, &limitedStream{Reader: r, Writer: transport.NewWriter(c1), Closer: c1})
+	defer closeConn()
+	ctx, cancel := testContext()
+	defer cancel()
+	if _, err := c.StartLaunch(ctx, &Config{Initialize: &protocol.InitializeRequestArguments{AdapterID: "test"}}, &protocol.LaunchRequestArguments{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The head of the response holds the sequence number of the request, which fails.
+	if _, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: large}); !errors.Is(err, transport.ErrMessageTooLarge) {
+		t.Errorf("got %v, want ErrMessageTooLarge", err)
+	}
+	if body, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "x"}); err != nil || body.Result != "x" {
+		t.Errorf("got %+v, %v after the message too large, want the result x", body, err)
+	}
+
+	// The response cannot be identified from its head, so the connection is closed.
+	if _, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "unidentified" + large}); err != ErrClosed {
+		t.Errorf("got %v, want ErrClosed", err)
+	}
+	select {
+	case <-c.Done():
+	case <-time.After(5 * time.Second):
+		t.Error("connection still open after the message which cannot be identified")
+	}
+}
+
+func TestClose(t *testing.T) {
+	c, closeConn := start(t, new(debugHandler), new(adapterStream))
+	ctx, cancel := testContext()
+	defer cancel()
+	if _, err := c.StartLaunch(ctx, &Config{Initialize: &protocol.InitializeRequestArguments{AdapterID: "test"}}, &protocol.LaunchRequestArguments{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The request waiting for its response fails when the connection is closed.
+	pending := make(chan error, 1)
+	go func() {
+		_, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "wait"})
+		pending <- err
+	}()
+	// Wait for the request to be sent.
+	for {
+		c.mu.Lock()
+		n := len(c.pending)
+		c.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	closeConn()
+	if err := <-pending; err != ErrClosed {
+		t.Errorf("got %v for the pending request, want ErrClosed", err)
+	}
+
+	// The requests sent after Close fail.
+	if _, err := c.Evaluate(ctx, &protocol.EvaluateArguments{Expression: "x"}); err != ErrClosed {
+		t.Errorf("got %v after Close, want ErrClosed", err)
+	}
+	if _, err := c.WaitForEvent(ctx, func(protocol.EventMessage) bool { return true }); err != ErrClosed {
+		t.Errorf("got %v waiting for an event after Close, want ErrClosed", err)
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+//go:generate go run ../cmd/dapgen -i ../api/debugAdapterProtocol.json -p client -rename Message=ErrorMessage -client -o requests.go
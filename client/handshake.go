@@ -0,0 +1,173 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// Config is the configuration of a debug session applied by StartLaunch and StartAttach
+// before the debuggee runs.
+type Config struct {
+	// Initialize is the arguments of the 'initialize' request. AdapterID is required.
+	Initialize *protocol.InitializeRequestArguments
+
+	// Breakpoints is the arguments of the 'setBreakpoints' requests, one per source.
+	Breakpoints []*protocol.SetBreakpointsArguments
+
+	// FunctionBreakpoints is the function breakpoints set by the 'setFunctionBreakpoints' request.
+	// They are not set if the debug adapter does not support them.
+	FunctionBreakpoints []*protocol.FunctionBreakpoint
+
+	// ExceptionBreakpoints is the arguments of the 'setExceptionBreakpoints' request.
+	// If nil, the request is sent without filters to debug adapters which have exception breakpoint filters.
+	ExceptionBreakpoints *protocol.SetExceptionBreakpointsArguments
+}
+
+// Session is a debug session ready to run, started by StartLaunch or StartAttach.
+type Session struct {
+	// Capabilities is the capabilities of the debug adapter returned by the 'initialize' request.
+	Capabilities *protocol.Capabilities
+
+	// Breakpoints is the breakpoints set by the 'setBreakpoints' requests, in the order of Config.Breakpoints.
+	Breakpoints [][]*protocol.Breakpoint
+
+	// FunctionBreakpoints is the breakpoints set by the 'setFunctionBreakpoints' request.
+	FunctionBreakpoints []*protocol.Breakpoint
+
+	// ExceptionBreakpoints is the breakpoints set by the 'setExceptionBreakpoints' request.
+	ExceptionBreakpoints []*protocol.Breakpoint
+}
+
+// StartLaunch starts a debug session launching the debuggee with args after configuring it with cfg.
+// See StartAttach for the sequence of the requests.
+func (c *Client) StartLaunch(ctx context.Context, cfg *Config, args *protocol.LaunchRequestArguments) (*Session, error) {
+	return c.start(ctx, cfg, &protocol.LaunchRequest{Arguments: args})
+}
+
+// StartAttach starts a debug session attaching to the debuggee with args after configuring it with cfg.
+//
+// It performs the initialization sequence of the protocol:
+// it sends the 'initialize' request, then the 'attach' request, and once the debug adapter sent the 'initialized' event,
+// it sets the breakpoints and exception filters of cfg and sends the 'configurationDone' request
+// if the debug adapter supports it. It returns when the response to the 'attach' request is received.
+//
+// The 'initialized' event is awaited whether the debug adapter sends it before the response to the 'initialize' request,
+// before or after the response to the 'attach' request.
+func (c *Client) StartAttach(ctx context.Context, cfg *Config, args *protocol.AttachRequestArguments) (*Session, error) {
+	return c.start(ctx, cfg, &protocol.AttachRequest{Arguments: args})
+}
+
+// start performs the initialization sequence with the 'launch' or 'attach' request req.
+func (c *Client) start(ctx context.Context, cfg *Config, req protocol.RequestMessage) (*Session, error) {
+	if cfg.Initialize == nil {
+		return nil, errors.New("client: missing arguments of the 'initialize' request")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	initialized := c.watch(func(e protocol.EventMessage) bool {
+		_, ok := e.(*protocol.InitializedEvent)
+		return ok
+	})
+	defer c.unwatch(initialized)
+
+	caps, err := c.Initialize(ctx, cfg.Initialize)
+	if err != nil {
+		return nil, err
+	}
+	if caps == nil {
+		caps = new(protocol.Capabilities)
+	}
+	s := &Session{Capabilities: caps}
+
+	// Some debug adapters reply to the 'launch' request only after the configuration is done,
+	// so it is sent without waiting for its response.
+	started := make(chan error, 1)
+	go func() {
+		_, err := c.Send(ctx, req)
+		started <- err
+	}()
+
+	done := false
+	select {
+	case <-initialized.ch:
+	case err := <-started:
+		if err != nil {
+			return nil, err
+		}
+		done = true
+		if _, err := c.wait(ctx, initialized); err != nil {
+			return nil, err
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, ErrClosed
+	}
+
+	if err := c.configure(ctx, cfg, s); err != nil {
+		return nil, err
+	}
+	if !done {
+		select {
+		case err := <-started:
+			if err != nil {
+				return nil, err
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return s, nil
+}
+
+// configure sets the breakpoints and exception filters of cfg and sends the 'configurationDone' request if supported.
+func (c *Client) configure(ctx context.Context, cfg *Config, s *Session) error {
+	for _, args := range cfg.Breakpoints {
+		body, err := c.SetBreakpoints(ctx, args)
+		if err != nil {
+			return err
+		}
+		if body == nil {
+			body = new(protocol.SetBreakpointsResponseBody)
+		}
+		s.Breakpoints = append(s.Breakpoints, body.Breakpoints)
+	}
+
+	if len(cfg.FunctionBreakpoints) > 0 && s.Capabilities.SupportsFunctionBreakpoints {
+		body, err := c.SetFunctionBreakpoints(ctx, &protocol.SetFunctionBreakpointsArguments{
+			Breakpoints: cfg.FunctionBreakpoints,
+		})
+		if err != nil {
+			return err
+		}
+		if body != nil {
+			s.FunctionBreakpoints = body.Breakpoints
+		}
+	}
+
+	args := cfg.ExceptionBreakpoints
+	if args == nil && len(s.Capabilities.ExceptionBreakpointFilters) > 0 {
+		args = &protocol.SetExceptionBreakpointsArguments{Filters: []string{}}
+	}
+	if args != nil {
+		body, err := c.SetExceptionBreakpoints(ctx, args)
+		if err != nil {
+			return err
+		}
+		if body != nil {
+			s.ExceptionBreakpoints = body.Breakpoints
+		}
+	}
+
+	if s.Capabilities.SupportsConfigurationDoneRequest {
+		return c.ConfigurationDone(ctx, &protocol.ConfigurationDoneArguments{})
+	}
+	return nil
+}
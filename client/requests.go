@@ -0,0 +1,460 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by dapgen; DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// Attach sends the 'attach' request, see protocol.AttachRequest.
+func (c *Client) Attach(ctx context.Context, args *protocol.AttachRequestArguments) error {
+	_, err := c.Send(ctx, &protocol.AttachRequest{Arguments: args})
+	return err
+}
+
+// BreakpointLocations sends the 'breakpointLocations' request, see protocol.BreakpointLocationsRequest.
+func (c *Client) BreakpointLocations(ctx context.Context, args *protocol.BreakpointLocationsArguments) (*protocol.BreakpointLocationsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.BreakpointLocationsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.BreakpointLocationsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Cancel sends the 'cancel' request, see protocol.CancelRequest.
+func (c *Client) Cancel(ctx context.Context, args *protocol.CancelArguments) error {
+	_, err := c.Send(ctx, &protocol.CancelRequest{Arguments: args})
+	return err
+}
+
+// Completions sends the 'completions' request, see protocol.CompletionsRequest.
+func (c *Client) Completions(ctx context.Context, args *protocol.CompletionsArguments) (*protocol.CompletionsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.CompletionsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.CompletionsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// ConfigurationDone sends the 'configurationDone' request, see protocol.ConfigurationDoneRequest.
+func (c *Client) ConfigurationDone(ctx context.Context, args *protocol.ConfigurationDoneArguments) error {
+	_, err := c.Send(ctx, &protocol.ConfigurationDoneRequest{Arguments: args})
+	return err
+}
+
+// Continue sends the 'continue' request, see protocol.ContinueRequest.
+func (c *Client) Continue(ctx context.Context, args *protocol.ContinueArguments) (*protocol.ContinueResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ContinueRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ContinueResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// DataBreakpointInfo sends the 'dataBreakpointInfo' request, see protocol.DataBreakpointInfoRequest.
+func (c *Client) DataBreakpointInfo(ctx context.Context, args *protocol.DataBreakpointInfoArguments) (*protocol.DataBreakpointInfoResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.DataBreakpointInfoRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.DataBreakpointInfoResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Disassemble sends the 'disassemble' request, see protocol.DisassembleRequest.
+func (c *Client) Disassemble(ctx context.Context, args *protocol.DisassembleArguments) (*protocol.DisassembleResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.DisassembleRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.DisassembleResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Disconnect sends the 'disconnect' request, see protocol.DisconnectRequest.
+func (c *Client) Disconnect(ctx context.Context, args *protocol.DisconnectArguments) error {
+	_, err := c.Send(ctx, &protocol.DisconnectRequest{Arguments: args})
+	return err
+}
+
+// Evaluate sends the 'evaluate' request, see protocol.EvaluateRequest.
+func (c *Client) Evaluate(ctx context.Context, args *protocol.EvaluateArguments) (*protocol.EvaluateResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.EvaluateRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.EvaluateResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// ExceptionInfo sends the 'exceptionInfo' request, see protocol.ExceptionInfoRequest.
+func (c *Client) ExceptionInfo(ctx context.Context, args *protocol.ExceptionInfoArguments) (*protocol.ExceptionInfoResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ExceptionInfoRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ExceptionInfoResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Goto sends the 'goto' request, see protocol.GotoRequest.
+func (c *Client) Goto(ctx context.Context, args *protocol.GotoArguments) error {
+	_, err := c.Send(ctx, &protocol.GotoRequest{Arguments: args})
+	return err
+}
+
+// GotoTargets sends the 'gotoTargets' request, see protocol.GotoTargetsRequest.
+func (c *Client) GotoTargets(ctx context.Context, args *protocol.GotoTargetsArguments) (*protocol.GotoTargetsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.GotoTargetsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.GotoTargetsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Initialize sends the 'initialize' request, see protocol.InitializeRequest.
+func (c *Client) Initialize(ctx context.Context, args *protocol.InitializeRequestArguments) (*protocol.Capabilities, error) {
+	resp, err := c.Send(ctx, &protocol.InitializeRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.InitializeResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Launch sends the 'launch' request, see protocol.LaunchRequest.
+func (c *Client) Launch(ctx context.Context, args *protocol.LaunchRequestArguments) error {
+	_, err := c.Send(ctx, &protocol.LaunchRequest{Arguments: args})
+	return err
+}
+
+// LoadedSources sends the 'loadedSources' request, see protocol.LoadedSourcesRequest.
+func (c *Client) LoadedSources(ctx context.Context, args *protocol.LoadedSourcesArguments) (*protocol.LoadedSourcesResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.LoadedSourcesRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.LoadedSourcesResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Locations sends the 'locations' request, see protocol.LocationsRequest.
+func (c *Client) Locations(ctx context.Context, args *protocol.LocationsArguments) (*protocol.LocationsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.LocationsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.LocationsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Modules sends the 'modules' request, see protocol.ModulesRequest.
+func (c *Client) Modules(ctx context.Context, args *protocol.ModulesArguments) (*protocol.ModulesResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ModulesRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ModulesResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Next sends the 'next' request, see protocol.NextRequest.
+func (c *Client) Next(ctx context.Context, args *protocol.NextArguments) error {
+	_, err := c.Send(ctx, &protocol.NextRequest{Arguments: args})
+	return err
+}
+
+// Pause sends the 'pause' request, see protocol.PauseRequest.
+func (c *Client) Pause(ctx context.Context, args *protocol.PauseArguments) error {
+	_, err := c.Send(ctx, &protocol.PauseRequest{Arguments: args})
+	return err
+}
+
+// ReadMemory sends the 'readMemory' request, see protocol.ReadMemoryRequest.
+func (c *Client) ReadMemory(ctx context.Context, args *protocol.ReadMemoryArguments) (*protocol.ReadMemoryResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ReadMemoryRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ReadMemoryResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Restart sends the 'restart' request, see protocol.RestartRequest.
+func (c *Client) Restart(ctx context.Context, args *protocol.RestartArguments) error {
+	_, err := c.Send(ctx, &protocol.RestartRequest{Arguments: args})
+	return err
+}
+
+// RestartFrame sends the 'restartFrame' request, see protocol.RestartFrameRequest.
+func (c *Client) RestartFrame(ctx context.Context, args *protocol.RestartFrameArguments) error {
+	_, err := c.Send(ctx, &protocol.RestartFrameRequest{Arguments: args})
+	return err
+}
+
+// ReverseContinue sends the 'reverseContinue' request, see protocol.ReverseContinueRequest.
+func (c *Client) ReverseContinue(ctx context.Context, args *protocol.ReverseContinueArguments) error {
+	_, err := c.Send(ctx, &protocol.ReverseContinueRequest{Arguments: args})
+	return err
+}
+
+// Scopes sends the 'scopes' request, see protocol.ScopesRequest.
+func (c *Client) Scopes(ctx context.Context, args *protocol.ScopesArguments) (*protocol.ScopesResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ScopesRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ScopesResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetBreakpoints sends the 'setBreakpoints' request, see protocol.SetBreakpointsRequest.
+func (c *Client) SetBreakpoints(ctx context.Context, args *protocol.SetBreakpointsArguments) (*protocol.SetBreakpointsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetBreakpointsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetBreakpointsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetDataBreakpoints sends the 'setDataBreakpoints' request, see protocol.SetDataBreakpointsRequest.
+func (c *Client) SetDataBreakpoints(ctx context.Context, args *protocol.SetDataBreakpointsArguments) (*protocol.SetDataBreakpointsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetDataBreakpointsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetDataBreakpointsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetExceptionBreakpoints sends the 'setExceptionBreakpoints' request, see protocol.SetExceptionBreakpointsRequest.
+func (c *Client) SetExceptionBreakpoints(ctx context.Context, args *protocol.SetExceptionBreakpointsArguments) (*protocol.SetExceptionBreakpointsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetExceptionBreakpointsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetExceptionBreakpointsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetExpression sends the 'setExpression' request, see protocol.SetExpressionRequest.
+func (c *Client) SetExpression(ctx context.Context, args *protocol.SetExpressionArguments) (*protocol.SetExpressionResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetExpressionRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetExpressionResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetFunctionBreakpoints sends the 'setFunctionBreakpoints' request, see protocol.SetFunctionBreakpointsRequest.
+func (c *Client) SetFunctionBreakpoints(ctx context.Context, args *protocol.SetFunctionBreakpointsArguments) (*protocol.SetFunctionBreakpointsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetFunctionBreakpointsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetFunctionBreakpointsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetInstructionBreakpoints sends the 'setInstructionBreakpoints' request, see protocol.SetInstructionBreakpointsRequest.
+func (c *Client) SetInstructionBreakpoints(ctx context.Context, args *protocol.SetInstructionBreakpointsArguments) (*protocol.SetInstructionBreakpointsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetInstructionBreakpointsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetInstructionBreakpointsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// SetVariable sends the 'setVariable' request, see protocol.SetVariableRequest.
+func (c *Client) SetVariable(ctx context.Context, args *protocol.SetVariableArguments) (*protocol.SetVariableResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SetVariableRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SetVariableResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Source sends the 'source' request, see protocol.SourceRequest.
+func (c *Client) Source(ctx context.Context, args *protocol.SourceArguments) (*protocol.SourceResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.SourceRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.SourceResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// StackTrace sends the 'stackTrace' request, see protocol.StackTraceRequest.
+func (c *Client) StackTrace(ctx context.Context, args *protocol.StackTraceArguments) (*protocol.StackTraceResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.StackTraceRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.StackTraceResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// StepBack sends the 'stepBack' request, see protocol.StepBackRequest.
+func (c *Client) StepBack(ctx context.Context, args *protocol.StepBackArguments) error {
+	_, err := c.Send(ctx, &protocol.StepBackRequest{Arguments: args})
+	return err
+}
+
+// StepIn sends the 'stepIn' request, see protocol.StepInRequest.
+func (c *Client) StepIn(ctx context.Context, args *protocol.StepInArguments) error {
+	_, err := c.Send(ctx, &protocol.StepInRequest{Arguments: args})
+	return err
+}
+
+// StepInTargets sends the 'stepInTargets' request, see protocol.StepInTargetsRequest.
+func (c *Client) StepInTargets(ctx context.Context, args *protocol.StepInTargetsArguments) (*protocol.StepInTargetsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.StepInTargetsRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.StepInTargetsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// StepOut sends the 'stepOut' request, see protocol.StepOutRequest.
+func (c *Client) StepOut(ctx context.Context, args *protocol.StepOutArguments) error {
+	_, err := c.Send(ctx, &protocol.StepOutRequest{Arguments: args})
+	return err
+}
+
+// Terminate sends the 'terminate' request, see protocol.TerminateRequest.
+func (c *Client) Terminate(ctx context.Context, args *protocol.TerminateArguments) error {
+	_, err := c.Send(ctx, &protocol.TerminateRequest{Arguments: args})
+	return err
+}
+
+// TerminateThreads sends the 'terminateThreads' request, see protocol.TerminateThreadsRequest.
+func (c *Client) TerminateThreads(ctx context.Context, args *protocol.TerminateThreadsArguments) error {
+	_, err := c.Send(ctx, &protocol.TerminateThreadsRequest{Arguments: args})
+	return err
+}
+
+// Threads sends the 'threads' request, see protocol.ThreadsRequest.
+func (c *Client) Threads(ctx context.Context) (*protocol.ThreadsResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.ThreadsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.ThreadsResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// Variables sends the 'variables' request, see protocol.VariablesRequest.
+func (c *Client) Variables(ctx context.Context, args *protocol.VariablesArguments) (*protocol.VariablesResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.VariablesRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.VariablesResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
+
+// WriteMemory sends the 'writeMemory' request, see protocol.WriteMemoryRequest.
+func (c *Client) WriteMemory(ctx context.Context, args *protocol.WriteMemoryArguments) (*protocol.WriteMemoryResponseBody, error) {
+	resp, err := c.Send(ctx, &protocol.WriteMemoryRequest{Arguments: args})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*protocol.WriteMemoryResponse)
+	if !ok {
+		return nil, unexpectedResponse(resp)
+	}
+	return r.Body, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// ErrTerminated is returned by the methods waiting for the debuggee to stop when it terminates or exits instead.
+var ErrTerminated = errors.New("client: debuggee terminated")
+
+// WaitForEvent waits for the first event received from now on for which match returns true and returns it.
+//
+// match is called from the goroutine reading the messages, so it must not send requests.
+// To wait for an event caused by a request without missing the event sent before its response,
+// use the methods such as ContinueUntilStopped instead.
+func (c *Client) WaitForEvent(ctx context.Context, match func(protocol.EventMessage) bool) (protocol.EventMessage, error) {
+	return c.wait(ctx, c.watch(match))
+}
+
+// ContinueUntilStopped sends the 'continue' request and waits for the debuggee to stop,
+// returning the body of the 'stopped' event.
+//
+// The 'stopped' event is awaited from before the request is sent, so it is not missed
+// if the debug adapter sends it before the response to the request.
+// The 'continued' events do not end the wait.
+// If the debuggee terminates or exits instead of stopping, ContinueUntilStopped returns ErrTerminated.
+func (c *Client) ContinueUntilStopped(ctx context.Context, args *protocol.ContinueArguments) (*protocol.StoppedEventBody, error) {
+	return c.runUntilStopped(ctx, func() error {
+		_, err := c.Continue(ctx, args)
+		return err
+	})
+}
+
+// StepOverAndWait sends the 'next' request and waits for the debuggee to stop like ContinueUntilStopped.
+func (c *Client) StepOverAndWait(ctx context.Context, args *protocol.NextArguments) (*protocol.StoppedEventBody, error) {
+	return c.runUntilStopped(ctx, func() error {
+		return c.Next(ctx, args)
+	})
+}
+
+// StepInAndWait sends the 'stepIn' request and waits for the debuggee to stop like ContinueUntilStopped.
+func (c *Client) StepInAndWait(ctx context.Context, args *protocol.StepInArguments) (*protocol.StoppedEventBody, error) {
+	return c.runUntilStopped(ctx, func() error {
+		return c.StepIn(ctx, args)
+	})
+}
+
+// StepOutAndWait sends the 'stepOut' request and waits for the debuggee to stop like ContinueUntilStopped.
+func (c *Client) StepOutAndWait(ctx context.Context, args *protocol.StepOutArguments) (*protocol.StoppedEventBody, error) {
+	return c.runUntilStopped(ctx, func() error {
+		return c.StepOut(ctx, args)
+	})
+}
+
+// runUntilStopped calls send and waits for the 'stopped', 'terminated' or 'exited' event.
+func (c *Client) runUntilStopped(ctx context.Context, send func() error) (*protocol.StoppedEventBody, error) {
+	w := c.watch(func(e protocol.EventMessage) bool {
+		switch e.(type) {
+		case *protocol.StoppedEvent, *protocol.TerminatedEvent, *protocol.ExitedEvent:
+			return true
+		}
+		return false
+	})
+	defer c.unwatch(w)
+
+	if err := send(); err != nil {
+		return nil, err
+	}
+	event, err := c.wait(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	switch e := event.(type) {
+	case *protocol.StoppedEvent:
+		if e.Body == nil {
+			return new(protocol.StoppedEventBody), nil
+		}
+		return e.Body, nil
+	case *protocol.ExitedEvent:
+		if e.Body != nil {
+			return nil, fmt.Errorf("%w with exit code %d", ErrTerminated, e.Body.ExitCode)
+		}
+	}
+	return nil, ErrTerminated
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+
+	"github.com/go-language-server/dap/protocol"
+)
+
+// watcher waits for the first event matching a predicate.
+//
+// The watchers are notified by the goroutine reading the messages before the responses received after the event,
+// so a watcher registered before sending a request cannot miss an event sent before the response.
+type watcher struct {
+	match func(protocol.EventMessage) bool
+	ch    chan protocol.EventMessage // receives the matching event
+}
+
+// watch registers the watcher of the first event received matching match.
+func (c *Client) watch(match func(protocol.EventMessage) bool) *watcher {
+	w := &watcher{
+		match: match,
+		ch:    make(chan protocol.EventMessage, 1),
+	}
+	c.mu.Lock()
+	c.watchers = append(c.watchers, w)
+	c.mu.Unlock()
+	return w
+}
+
+// unwatch unregisters w.
+func (c *Client) unwatch(w *watcher) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, v := range c.watchers {
+		if v == w {
+			c.watchers = append(c.watchers[:i], c.watchers[i+1:]...)
+			return
+		}
+	}
+}
+
+// notify passes event to the watchers it matches and unregisters them.
+func (c *Client) notify(event protocol.EventMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	watchers := c.watchers[:0]
+	for _, w := range c.watchers {
+		if w.match(event) {
+			w.ch <- event
+			continue
+		}
+		watchers = append(watchers, w)
+	}
+	for i := len(watchers); i < len(c.watchers); i++ {
+		c.watchers[i] = nil
+	}
+	c.watchers = watchers
+}
+
+// wait waits for the event of w.
+// It returns ErrClosed if the connection is closed before the event is received.
+func (c *Client) wait(ctx context.Context, w *watcher) (protocol.EventMessage, error) {
+	defer c.unwatch(w)
+	select {
+	case event := <-w.ch:
+		return event, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		select {
+		case event := <-w.ch:
+			return event, nil
+		default:
+			return nil, ErrClosed
+		}
+	}
+}
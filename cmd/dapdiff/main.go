@@ -0,0 +1,70 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command dapdiff reports the difference between two versions of the Debug Adapter Protocol JSON Schema.
+//
+// It lists the requests, events, capabilities, types and fields added and removed by the new schema,
+// and the changes of the Go types generated by dapgen which break the code using them.
+//
+// Usage:
+//
+//	dapdiff [-rename def=Name,...] [-breaking] old.json new.json
+//
+// For example, to check the impact of a new version of the specification:
+//
+//	dapdiff api/debugAdapterProtocol.json debugAdapterProtocol.json
+//
+// With -breaking, dapdiff exits with status 1 if there are breaking changes.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/go-language-server/dap/internal/gen"
+)
+
+func main() {
+	var (
+		rename   = flag.String("rename", "Message=ErrorMessage", "comma-separated list of definition=GoName renames passed to dapgen")
+		breaking = flag.Bool("breaking", false, "exit with status 1 if there are breaking changes of the Go types")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: dapdiff [flags] old.json new.json\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("dapdiff: ")
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var generators [2]*gen.Generator
+	for i, filename := range flag.Args() {
+		doc, err := gen.ReadDocument(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		g := gen.New(doc)
+		opts := gen.Options{Rename: *rename}
+		opts.Apply(g)
+		generators[i] = g
+	}
+
+	report, err := gen.Diff(generators[0], generators[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := report.WriteTo(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	if *breaking && len(report.Breaking) > 0 {
+		os.Exit(1)
+	}
+}
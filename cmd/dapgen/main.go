@@ -0,0 +1,82 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command dapgen generates the Go types of the Debug Adapter Protocol from its JSON Schema.
+//
+// It resolves the $ref and allOf compositions of the schema definitions, and emits the Go types
+// sorted by name with their fields sorted by name, so that the output only depends on the schema.
+//
+// Usage:
+//
+//	dapgen -i schema.json -p package [-o file.go] [-int names] [-rename def=Name,...] [-handler|-client] [-check]
+//
+// With -handler, dapgen generates the Handler interface of a debug adapter with a method per request
+// instead of the types. With -client, it generates the methods of the client sending the requests.
+//
+// With -check, dapgen does not write the output file but exits with a non-zero status
+// if its content differs from the generated code, which is used to verify that
+// the checked-in code is up to date with the schema and the generator.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/go-language-server/dap/internal/gen"
+)
+
+func main() {
+	var (
+		input  = flag.String("i", "", "input JSON Schema file")
+		output = flag.String("o", "", "output Go file")
+		pkg    = flag.String("p", "", "package name of output Go file")
+		check  = flag.Bool("check", false, "check that the output file is up to date instead of writing it")
+		opts   gen.Options
+	)
+	opts.RegisterFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: dapgen -i schema.json -p package [-o file.go] [flags]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("dapgen: ")
+
+	if *input == "" || *pkg == "" || (*check && *output == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	doc, err := gen.ReadDocument(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := opts.Generate(doc, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch {
+	case *check:
+		current, err := ioutil.ReadFile(*output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !bytes.Equal(current, src) {
+			log.Fatalf("%s is out of date with %s; run go generate", *output, *input)
+		}
+	case *output == "":
+		if _, err := os.Stdout.Write(src); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
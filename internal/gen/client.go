@@ -0,0 +1,46 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+)
+
+// GenerateClient returns the Go source file of package pkg declaring a method of Client per request
+// sent by the client, which sends the request and returns the body of its response.
+func (g *Generator) GenerateClient(pkg string) ([]byte, error) {
+	if err := g.build(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n\n\t%q\n)\n", protocolPackage)
+
+	for _, m := range g.requestMethods() {
+		fmt.Fprintf(&buf, "\n// %s sends the '%s' request, see protocol.%s.\n", m.Name, m.Command, m.Request)
+		fmt.Fprintf(&buf, "func (c *Client) %s(%s) %s {\n", m.Name, m.params(), m.results())
+		req := fmt.Sprintf("&protocol.%s{}", m.Request)
+		if m.Arguments != "" {
+			req = fmt.Sprintf("&protocol.%s{Arguments: args}", m.Request)
+		}
+		if m.Body == "" {
+			fmt.Fprintf(&buf, "\t_, err := c.Send(ctx, %s)\n\treturn err\n}\n", req)
+			continue
+		}
+		fmt.Fprintf(&buf, "\tresp, err := c.Send(ctx, %s)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n", req)
+		fmt.Fprintf(&buf, "\tr, ok := resp.(*protocol.%s)\n\tif !ok {\n\t\treturn nil, unexpectedResponse(resp)\n\t}\n", m.Response)
+		buf.WriteString("\treturn r.Body, nil\n}\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return src, nil
+}
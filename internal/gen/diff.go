@@ -0,0 +1,223 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Change is the set of names added and removed between two versions of a schema.
+type Change struct {
+	Added   []string
+	Removed []string
+}
+
+// Empty reports whether nothing was added or removed.
+func (c *Change) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0
+}
+
+// Report is the difference between two versions of the protocol schema.
+type Report struct {
+	// Requests is the change of the commands of the requests.
+	Requests Change
+
+	// Events is the change of the event types.
+	Events Change
+
+	// Capabilities is the change of the capabilities of the debug adapter.
+	Capabilities Change
+
+	// ClientCapabilities is the change of the capabilities of the client passed by the 'initialize' request.
+	ClientCapabilities Change
+
+	// Types is the change of the generated Go types.
+	Types Change
+
+	// Fields is the change of the properties of the generated Go types in the form "Type.property".
+	// The properties of the added and removed types are not listed.
+	Fields Change
+
+	// Breaking lists the changes of the generated Go types which break the code using them,
+	// such as a removed field or a field whose type changed.
+	Breaking []string
+}
+
+// Diff compares the types generated by from and to and returns the Report of the change from the former to the latter.
+func Diff(from, to *Generator) (*Report, error) {
+	if err := from.build(); err != nil {
+		return nil, fmt.Errorf("old schema: %w", err)
+	}
+	if err := to.build(); err != nil {
+		return nil, fmt.Errorf("new schema: %w", err)
+	}
+
+	r := to.diffMessages(from)
+	for _, name := range sortedTypeNames(from.types) {
+		if _, ok := to.types[name]; !ok {
+			r.Types.Removed = append(r.Types.Removed, name)
+			r.Breaking = append(r.Breaking, fmt.Sprintf("removed type %s", name))
+		}
+	}
+	for _, name := range sortedTypeNames(to.types) {
+		t := to.types[name]
+		ot, ok := from.types[name]
+		if !ok {
+			r.Types.Added = append(r.Types.Added, name)
+			continue
+		}
+		r.diffType(ot, t)
+	}
+	return r, nil
+}
+
+// diffMessages returns the Report of the change of the messages and capabilities from old to g.
+func (g *Generator) diffMessages(old *Generator) *Report {
+	return &Report{
+		Requests:           diffNames(old.enumValues("command", "Request"), g.enumValues("command", "Request")),
+		Events:             diffNames(old.enumValues("event", "Event"), g.enumValues("event", "Event")),
+		Capabilities:       diffNames(old.properties("Capabilities"), g.properties("Capabilities")),
+		ClientCapabilities: diffNames(old.properties("InitializeRequestArguments"), g.properties("InitializeRequestArguments")),
+	}
+}
+
+// diffType adds the change of the Go type old to t to r.
+func (r *Report) diffType(old, t *goType) {
+	switch {
+	case old.Enum != nil && t.Enum == nil:
+		r.Breaking = append(r.Breaking, fmt.Sprintf("%s: changed from string to struct", t.Name))
+		return
+	case old.Enum == nil && t.Enum != nil:
+		r.Breaking = append(r.Breaking, fmt.Sprintf("%s: changed from struct to string", t.Name))
+		return
+	case old.Enum != nil:
+		values := make(map[string]bool, len(t.Enum))
+		for _, v := range t.Enum {
+			values[v.Name] = true
+		}
+		for _, v := range old.Enum {
+			if !values[v.Name] {
+				r.Breaking = append(r.Breaking, fmt.Sprintf("removed constant %s", v.Name))
+			}
+		}
+		return
+	}
+
+	if old.Base != t.Base {
+		r.Breaking = append(r.Breaking, fmt.Sprintf("%s: changed embedded type from %q to %q", t.Name, old.Base, t.Base))
+	}
+	fields := make(map[string]*goField, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.JSONName] = f
+	}
+	for _, of := range old.Fields {
+		f, ok := fields[of.JSONName]
+		switch {
+		case !ok:
+			r.Fields.Removed = append(r.Fields.Removed, t.Name+"."+of.JSONName)
+			r.Breaking = append(r.Breaking, fmt.Sprintf("removed field %s.%s", t.Name, of.Name))
+		case f.Type != of.Type:
+			r.Breaking = append(r.Breaking, fmt.Sprintf("%s.%s: changed type from %s to %s", t.Name, f.Name, of.Type, f.Type))
+		}
+		delete(fields, of.JSONName)
+	}
+	for _, f := range t.Fields {
+		if _, ok := fields[f.JSONName]; ok {
+			r.Fields.Added = append(r.Fields.Added, t.Name+"."+f.JSONName)
+		}
+	}
+}
+
+// WriteTo writes the human readable report to w.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{w: w}
+	sections := []struct {
+		title  string
+		change *Change
+	}{
+		{"Requests", &r.Requests},
+		{"Events", &r.Events},
+		{"Capabilities", &r.Capabilities},
+		{"Client capabilities", &r.ClientCapabilities},
+		{"Types", &r.Types},
+		{"Fields", &r.Fields},
+	}
+	for _, s := range sections {
+		if s.change.Empty() {
+			continue
+		}
+		fmt.Fprintf(cw, "%s:\n", s.title)
+		for _, name := range s.change.Added {
+			fmt.Fprintf(cw, "\t+ %s\n", name)
+		}
+		for _, name := range s.change.Removed {
+			fmt.Fprintf(cw, "\t- %s\n", name)
+		}
+	}
+	if len(r.Breaking) > 0 {
+		fmt.Fprintf(cw, "Breaking changes of the Go types:\n")
+		for _, change := range r.Breaking {
+			fmt.Fprintf(cw, "\t! %s\n", change)
+		}
+	}
+	return cw.n, cw.err
+}
+
+// countWriter counts the bytes written to w and keeps the first error.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	w.err = err
+	return n, err
+}
+
+// properties returns the set of the property names of the definition.
+func (g *Generator) properties(def string) map[string]string {
+	props := make(map[string]string)
+	s, ok := g.doc.Definitions[def]
+	if !ok {
+		return props
+	}
+	for name := range g.resolve(s).Properties {
+		props[name] = name
+	}
+	return props
+}
+
+// diffNames returns the Change of the keys of from to to.
+func diffNames(from, to map[string]string) Change {
+	var c Change
+	for _, name := range sortedStrings(to) {
+		if _, ok := from[name]; !ok {
+			c.Added = append(c.Added, name)
+		}
+	}
+	for _, name := range sortedStrings(from) {
+		if _, ok := to[name]; !ok {
+			c.Removed = append(c.Removed, name)
+		}
+	}
+	return c
+}
+
+func sortedTypeNames(m map[string]*goType) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// oldSchema and newSchema are two versions of a small schema
+// covering each kind of change reported by Diff.
+const (
+	oldSchema = `{"definitions": {
+	"ProtocolMessage": {"type": "object", "properties": {"seq": {"type": "integer"}, "type": {"type": "string"}}, "required": ["seq", "type"]},
+	"Request": {"allOf": [{"$ref": "#/definitions/ProtocolMessage"}, {"type": "object", "properties": {"command": {"type": "string"}}, "required": ["command"]}]},
+	"Event": {"allOf": [{"$ref": "#/definitions/ProtocolMessage"}, {"type": "object", "properties": {"event": {"type": "string"}}, "required": ["event"]}]},
+	"NextRequest": {"allOf": [{"$ref": "#/definitions/Request"}, {"type": "object", "properties": {"command": {"type": "string", "enum": ["next"]}, "arguments": {"$ref": "#/definitions/NextArguments"}}, "required": ["command", "arguments"]}]},
+	"NextArguments": {"type": "object", "properties": {"threadId": {"type": "integer"}, "granularity": {"$ref": "#/definitions/SteppingGranularity"}}, "required": ["threadId"]},
+	"GotoRequest": {"allOf": [{"$ref": "#/definitions/Request"}, {"type": "object", "properties": {"command": {"type": "string", "enum": ["goto"]}}, "required": ["command"]}]},
+	"StoppedEvent": {"allOf": [{"$ref": "#/definitions/Event"}, {"type": "object", "properties": {"event": {"type": "string", "enum": ["stopped"]}}, "required": ["event"]}]},
+	"ExitedEvent": {"allOf": [{"$ref": "#/definitions/Event"}, {"type": "object", "properties": {"event": {"type": "string", "enum": ["exited"]}}, "required": ["event"]}]},
+	"SteppingGranularity": {"type": "string", "enum": ["statement", "line", "instruction"]},
+	"Capabilities": {"type": "object", "properties": {"supportsGotoTargetsRequest": {"type": "boolean"}, "supportsStepBack": {"type": "boolean"}}},
+	"InitializeRequestArguments": {"type": "object", "properties": {"adapterID": {"type": "string"}}, "required": ["adapterID"]},
+	"Thread": {"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}, "required": ["id", "name"]},
+	"Source": {"type": "object", "properties": {"name": {"type": "string"}, "path": {"type": "string"}}},
+	"Named": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]},
+	"Labeled": {"type": "object", "properties": {"label": {"type": "string"}}, "required": ["label"]},
+	"Variable": {"allOf": [{"$ref": "#/definitions/Named"}, {"type": "object", "properties": {"value": {"type": "string"}}, "required": ["value"]}]}
+}}`
+
+	newSchema = `{"definitions": {
+	"ProtocolMessage": {"type": "object", "properties": {"seq": {"type": "integer"}, "type": {"type": "string"}}, "required": ["seq", "type"]},
+	"Request": {"allOf": [{"$ref": "#/definitions/ProtocolMessage"}, {"type": "object", "properties": {"command": {"type": "string"}}, "required": ["command"]}]},
+	"Event": {"allOf": [{"$ref": "#/definitions/ProtocolMessage"}, {"type": "object", "properties": {"event": {"type": "string"}}, "required": ["event"]}]},
+	"NextRequest": {"allOf": [{"$ref": "#/definitions/Request"}, {"type": "object", "properties": {"command": {"type": "string", "enum": ["next"]}, "arguments": {"$ref": "#/definitions/NextArguments"}}, "required": ["command", "arguments"]}]},
+	"NextArguments": {"type": "object", "properties": {"threadId": {"type": "integer"}, "granularity": {"$ref": "#/definitions/SteppingGranularity"}}, "required": ["threadId"]},
+	"LocationsRequest": {"allOf": [{"$ref": "#/definitions/Request"}, {"type": "object", "properties": {"command": {"type": "string", "enum": ["locations"]}}, "required": ["command"]}]},
+	"StoppedEvent": {"allOf": [{"$ref": "#/definitions/Event"}, {"type": "object", "properties": {"event": {"type": "string", "enum": ["stopped"]}}, "required": ["event"]}]},
+	"ContinuedEvent": {"allOf": [{"$ref": "#/definitions/Event"}, {"type": "object", "properties": {"event": {"type": "string", "enum": ["continued"]}}, "required": ["event"]}]},
+	"SteppingGranularity": {"type": "string", "enum": ["statement", "line"]},
+	"Capabilities": {"type": "object", "properties": {"supportsStepBack": {"type": "boolean"}, "supportsANSIStyling": {"type": "boolean"}}},
+	"InitializeRequestArguments": {"type": "object", "properties": {"adapterID": {"type": "string"}, "supportsANSIStyling": {"type": "boolean"}}, "required": ["adapterID"]},
+	"Thread": {"type": "object", "properties": {"id": {"type": "string"}, "name": {"type": "string"}}, "required": ["id", "name"]},
+	"Source": {"type": "object", "properties": {"name": {"type": "string"}}},
+	"Named": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]},
+	"Labeled": {"type": "object", "properties": {"label": {"type": "string"}}, "required": ["label"]},
+	"Variable": {"allOf": [{"$ref": "#/definitions/Labeled"}, {"type": "object", "properties": {"value": {"type": "string"}}, "required": ["value"]}]}
+}}`
+)
+
+// newTestGenerator returns the Generator of the inline schema.
+func newTestGenerator(t *testing.T, schema string) *Generator {
+	t.Helper()
+	var doc Document
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		t.Fatal(err)
+	}
+	return New(&doc)
+}
+
+func TestDiff(t *testing.T) {
+	r, err := Diff(newTestGenerator(t, oldSchema), newTestGenerator(t, newSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Report{
+		Requests:           Change{Added: []string{"locations"}, Removed: []string{"goto"}},
+		Events:             Change{Added: []string{"continued"}, Removed: []string{"exited"}},
+		Capabilities:       Change{Added: []string{"supportsANSIStyling"}, Removed: []string{"supportsGotoTargetsRequest"}},
+		ClientCapabilities: Change{Added: []string{"supportsANSIStyling"}},
+		Types:              Change{Added: []string{"ContinuedEvent", "LocationsRequest"}, Removed: []string{"ExitedEvent", "GotoRequest"}},
+		Fields: Change{
+			Added:   []string{"Capabilities.supportsANSIStyling", "InitializeRequestArguments.supportsANSIStyling"},
+			Removed: []string{"Capabilities.supportsGotoTargetsRequest", "Source.path"},
+		},
+		Breaking: []string{
+			"removed type ExitedEvent",
+			"removed type GotoRequest",
+			"removed field Capabilities.SupportsGotoTargetsRequest",
+			"removed field Source.Path",
+			"removed constant SteppingGranularityInstruction",
+			"Thread.Id: changed type from int to string",
+			`Variable: changed embedded type from "Named" to "Labeled"`,
+		},
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("got report\n%s", report(t, r))
+	}
+}
+
+func TestDiffSame(t *testing.T) {
+	r, err := Diff(newTestGenerator(t, newSchema), newTestGenerator(t, newSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := report(t, r); got != "" {
+		t.Errorf("got report\n%s\nfor the same schema, want none", got)
+	}
+}
+
+// report returns the report r written by WriteTo.
+func report(t *testing.T, r *Report) string {
+	t.Helper()
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo returned %d, wrote %d bytes", n, buf.Len())
+	}
+	return buf.String()
+}
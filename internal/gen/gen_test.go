@@ -0,0 +1,94 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// generateDirective is the prefix of the go:generate lines running dapgen.
+const generateDirective = "//go:generate go run ../cmd/dapgen "
+
+// TestGenerated checks that regenerating the checked-in code with the flags of the go:generate lines
+// of the packages produces no diff.
+func TestGenerated(t *testing.T) {
+	var outputs []string
+	for _, dir := range []string{"protocol", "adapter", "client"} {
+		dir = filepath.Join("..", "..", dir)
+		for _, args := range generateArgs(t, filepath.Join(dir, "generate.go")) {
+			output := checkGenerated(t, dir, args)
+			outputs = append(outputs, filepath.Join(filepath.Base(dir), output))
+		}
+	}
+
+	want := []string{"protocol/dap.go", "adapter/adapter.go", "adapter/handler.go", "client/requests.go"}
+	if strings.Join(outputs, " ") != strings.Join(want, " ") {
+		t.Errorf("checked %v, want %v", outputs, want)
+	}
+}
+
+// generateArgs returns the arguments of dapgen in the go:generate lines of the file.
+func generateArgs(t *testing.T, filename string) [][]string {
+	t.Helper()
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var args [][]string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if line := sc.Text(); strings.HasPrefix(line, generateDirective) {
+			args = append(args, strings.Fields(strings.TrimPrefix(line, generateDirective)))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return args
+}
+
+// checkGenerated generates the code as dapgen run in dir with args does,
+// compares it with the output file and returns the name of the output file.
+func checkGenerated(t *testing.T, dir string, args []string) string {
+	t.Helper()
+	fs := flag.NewFlagSet("dapgen", flag.ContinueOnError)
+	var (
+		input  = fs.String("i", "", "")
+		output = fs.String("o", "", "")
+		pkg    = fs.String("p", "", "")
+		opts   Options
+	)
+	opts.RegisterFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("%s: %v", dir, err)
+	}
+
+	doc, err := ReadDocument(filepath.Join(dir, *input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := opts.Generate(doc, *pkg)
+	if err != nil {
+		t.Fatalf("%s: %v", *output, err)
+	}
+
+	current, err := ioutil.ReadFile(filepath.Join(dir, *output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(current, src) {
+		t.Errorf("%s is out of date with %s; run go generate", filepath.Join(filepath.Base(dir), *output), *input)
+	}
+	return *output
+}
@@ -0,0 +1,784 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gen generates the Go types of the Debug Adapter Protocol from its JSON Schema,
+// and compares the types generated from two versions of the schema.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// Generator generates Go types from the Document.
+type Generator struct {
+	// Ints is the set of property names whose "number" type is an integer.
+	// It is used for schemas converted from TypeScript, which has no integer type.
+	Ints map[string]bool
+
+	// Renames maps the definition names, and the names of the types of inline object properties,
+	// to the Go type names used instead of them.
+	// The properties renamed to the same name share the type.
+	Renames map[string]string
+
+	doc   *Document
+	types map[string]*goType
+}
+
+// New returns a Generator of the types defined in doc.
+func New(doc *Document) *Generator {
+	return &Generator{
+		Ints:    make(map[string]bool),
+		Renames: make(map[string]string),
+		doc:     doc,
+	}
+}
+
+// goType is a Go struct type emitted by the generator.
+type goType struct {
+	Name        string
+	Description string
+	Fields      []*goField
+	Message     bool
+
+	// Base is the name of the embedded type the type is derived from by allOf.
+	Base string
+
+	// Enum is the allowed values of the string type.
+	Enum []*goEnumValue
+
+	// Closed reports whether Enum lists all the allowed values rather than the well-known ones.
+	Closed bool
+}
+
+// goEnumValue is a constant of the string type goType.
+type goEnumValue struct {
+	Name        string
+	Value       string
+	Description string
+}
+
+// goField is a field of goType.
+type goField struct {
+	Name        string
+	JSONName    string
+	Type        string
+	Description string
+	Required    bool
+}
+
+// Generate returns the Go source file of package pkg declaring the types of the definitions.
+func (g *Generator) Generate(pkg string) ([]byte, error) {
+	if err := g.build(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	names := make([]string, 0, len(g.types))
+	for name := range g.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		t := g.types[name]
+		buf.WriteString("\n")
+		writeComment(&buf, "", t.Name, t.Description)
+		if t.Enum != nil {
+			writeEnum(&buf, t)
+			if t.Closed && g.hasMessages() {
+				writeValid(&buf, t)
+			}
+			continue
+		}
+		if len(t.Fields) == 0 && t.Base == "" {
+			fmt.Fprintf(&buf, "type %s struct{}\n", t.Name)
+			continue
+		}
+		fmt.Fprintf(&buf, "type %s struct {\n", t.Name)
+		if t.Base != "" {
+			fmt.Fprintf(&buf, "\t%s\n", t.Base)
+		}
+		for i, f := range t.Fields {
+			if f.Description != "" && (i > 0 || t.Base != "") {
+				buf.WriteString("\n")
+			}
+			writeComment(&buf, "\t", "", f.Description)
+			tag := f.JSONName
+			if !f.Required {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(&buf, "\t%s %s `json:\"%s\"`\n", f.Name, f.Type, tag)
+		}
+		buf.WriteString("}\n")
+
+		if g.hasMessages() {
+			g.writeAccessors(&buf, t)
+		}
+	}
+
+	if g.hasMessages() {
+		g.writeMessageTypes(&buf)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "package %s\n", pkg)
+	if bytes.Contains(buf.Bytes(), []byte("json.RawMessage")) {
+		out.WriteString("\nimport \"encoding/json\"\n")
+	}
+	out.Write(buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), err
+	}
+	return src, nil
+}
+
+// build builds the Go types of the definitions.
+func (g *Generator) build() error {
+	if g.types != nil {
+		return nil
+	}
+	if err := g.checkRefs(); err != nil {
+		return err
+	}
+
+	g.types = make(map[string]*goType)
+	for _, name := range sortedKeys(g.doc.Definitions) {
+		def := g.doc.Definitions[name]
+		s := g.resolve(def)
+		if isEnum(s) {
+			g.addEnum(g.typeName(name), s)
+			continue
+		}
+		if !isObject(s) {
+			continue
+		}
+		base, inherited := g.base(def)
+		g.addType(g.typeName(name), s, base, inherited)
+	}
+	return nil
+}
+
+// checkRefs reports the first reference to an undefined definition.
+func (g *Generator) checkRefs() error {
+	var check func(path string, s *Schema) error
+	check = func(path string, s *Schema) error {
+		if s == nil {
+			return nil
+		}
+		if s.Ref != "" {
+			if _, ok := g.doc.Definitions[refName(s.Ref)]; !ok {
+				return fmt.Errorf("%s: unknown reference %q", path, s.Ref)
+			}
+		}
+		for _, name := range sortedKeys(s.Properties) {
+			if err := check(path+".properties."+name, s.Properties[name]); err != nil {
+				return err
+			}
+		}
+		for i, part := range s.AllOf {
+			if err := check(fmt.Sprintf("%s.allOf[%d]", path, i), part); err != nil {
+				return err
+			}
+		}
+		for i, part := range s.OneOf {
+			if err := check(fmt.Sprintf("%s.oneOf[%d]", path, i), part); err != nil {
+				return err
+			}
+		}
+		return check(path+".items", s.Items)
+	}
+	for _, name := range sortedKeys(g.doc.Definitions) {
+		if err := check("definitions."+name, g.doc.Definitions[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accessors maps the kind of message to the accessor methods of its message interface keyed by the property they return.
+var accessors = map[string]map[string]string{
+	"Request": {
+		"arguments": "GetArguments",
+	},
+	"Response": {
+		"body": "GetBody",
+	},
+	"Event": {
+		"body": "GetBody",
+	},
+}
+
+// writeAccessors writes the accessor methods of the fields t overrides from its base message type.
+func (g *Generator) writeAccessors(buf *bytes.Buffer, t *goType) {
+	kind := g.messageKind(t)
+	if kind == "" {
+		return
+	}
+	for _, f := range t.Fields {
+		method, ok := accessors[kind][f.JSONName]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(buf, "\n// %s implements %sMessage.\n", method, kind)
+		fmt.Fprintf(buf, "func (m *%s) %s() interface{} { return m.%s }\n", t.Name, method, f.Name)
+	}
+}
+
+// messageKind returns the name of the message type t is derived from, that is Request, Response or Event.
+func (g *Generator) messageKind(t *goType) string {
+	for base := t.Base; base != ""; base = g.types[base].Base {
+		if _, ok := accessors[base]; ok {
+			return base
+		}
+	}
+	return ""
+}
+
+// hasMessages reports whether the schema defines the protocol messages.
+func (g *Generator) hasMessages() bool {
+	_, ok := g.doc.Definitions["ProtocolMessage"]
+	return ok
+}
+
+// writeMessageTypes writes the tables of the message types used to decode messages.
+func (g *Generator) writeMessageTypes(buf *bytes.Buffer) {
+	commands := g.enumValues("command", "Request")
+	events := g.enumValues("event", "Event")
+
+	buf.WriteString("\n// requestTypes maps the command of a request to the constructor of the request.\n")
+	buf.WriteString("var requestTypes = map[string]func() RequestMessage{\n")
+	for _, command := range sortedStrings(commands) {
+		fmt.Fprintf(buf, "\t%q: func() RequestMessage { return new(%s) },\n", command, commands[command])
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n// responseTypes maps the command of a request to the constructor of its response.\n")
+	buf.WriteString("var responseTypes = map[string]func() ResponseMessage{\n")
+	for _, command := range sortedStrings(commands) {
+		response := strings.TrimSuffix(commands[command], "Request") + "Response"
+		if _, ok := g.types[response]; !ok {
+			continue
+		}
+		fmt.Fprintf(buf, "\t%q: func() ResponseMessage { return new(%s) },\n", command, response)
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n// eventTypes maps the event type to the constructor of the event.\n")
+	buf.WriteString("var eventTypes = map[string]func() EventMessage{\n")
+	for _, event := range sortedStrings(events) {
+		fmt.Fprintf(buf, "\t%q: func() EventMessage { return new(%s) },\n", event, events[event])
+	}
+	buf.WriteString("}\n")
+}
+
+// enumValues returns the Go type names of the definitions with the suffix keyed by the single enum value of their property prop,
+// such as the command of requests.
+func (g *Generator) enumValues(prop, suffix string) map[string]string {
+	values := make(map[string]string)
+	for name, def := range g.doc.Definitions {
+		s := g.resolve(def)
+		p, ok := s.Properties[prop]
+		if !ok || len(p.Enum) != 1 || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		values[fmt.Sprint(p.Enum[0])] = g.typeName(name)
+	}
+	return values
+}
+
+const header = `// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by dapgen; DO NOT EDIT.
+
+`
+
+// defaultTrue is the set of optional boolean properties which are assumed to be true when missing.
+// They are generated as *bool to tell a missing property from false.
+var defaultTrue = map[string]bool{
+	"ContinueResponseBody.allThreadsContinued":   true,
+	"InitializeRequestArguments.columnsStartAt1": true,
+	"InitializeRequestArguments.linesStartAt1":   true,
+	"StackFrame.canRestart":                      true,
+}
+
+// base returns the name of the type the definition s is derived from by allOf,
+// and the set of properties inherited from it without change.
+func (g *Generator) base(s *Schema) (string, map[string]bool) {
+	if len(s.AllOf) == 0 || s.AllOf[0].Ref == "" {
+		return "", nil
+	}
+	base := g.resolve(g.lookup(s.AllOf[0].Ref))
+	if !isObject(base) {
+		return "", nil
+	}
+
+	inherited := make(map[string]bool)
+	for prop, p := range base.Properties {
+		inherited[prop] = true
+		for _, part := range s.AllOf[1:] {
+			if override, ok := part.Properties[prop]; ok && !refines(p, override) {
+				inherited[prop] = false
+			}
+		}
+	}
+	return g.typeName(refName(s.AllOf[0].Ref)), inherited
+}
+
+// refines reports whether override only narrows down the values or documents the property schema base
+// without changing its type.
+func refines(base, override *Schema) bool {
+	if override.Ref != "" || override.Properties != nil || override.Items != nil {
+		return false
+	}
+	if len(override.Type) == 0 {
+		return true
+	}
+	if len(override.Type) != len(base.Type) {
+		return false
+	}
+	for i := range override.Type {
+		if override.Type[i] != base.Type[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// extensions maps the types which may have additional implementation specific attributes to the field keeping them.
+// Their MarshalJSON and UnmarshalJSON methods which fill the field are not generated.
+// They only apply to the protocol schema which defines the messages.
+var extensions = map[string]*goField{
+	"AttachRequestArguments": rawArguments,
+	"LaunchRequestArguments": rawArguments,
+	"Module": {
+		Name:        "Extra",
+		JSONName:    "-",
+		Type:        "map[string]json.RawMessage",
+		Description: "Extra holds the additional attributes which are not defined by the protocol.",
+		Required:    true,
+	},
+}
+
+var rawArguments = &goField{
+	Name:        "Raw",
+	JSONName:    "-",
+	Type:        "json.RawMessage",
+	Description: "Raw is the JSON encoding of the arguments including the implementation specific attributes.",
+	Required:    true,
+}
+
+// addType adds the object schema s as the named Go struct type.
+// The base type is embedded in place of the inherited properties.
+func (g *Generator) addType(name string, s *Schema, base string, inherited map[string]bool) {
+	if _, ok := g.types[name]; ok {
+		return
+	}
+	t := &goType{
+		Name:        name,
+		Description: s.Description,
+		Message:     isMessage(s),
+		Base:        base,
+	}
+	g.types[name] = t
+
+	seen := make(map[string]bool)
+	for _, prop := range sortedKeys(s.Properties) {
+		p := s.Properties[prop]
+		if seen[goName(prop)] || inherited[prop] {
+			continue
+		}
+		seen[goName(prop)] = true
+		f := &goField{
+			Name:        goName(prop),
+			JSONName:    prop,
+			Description: fieldDescription(p),
+			Required:    contains(s.Required, prop),
+		}
+		f.Type = g.goType(name, f.Name, p)
+		if f.Type == "interface{}" && isMessage(s) && (prop == "arguments" || prop == "body") {
+			f.Type = "json.RawMessage"
+		}
+		if f.Type == "float64" && g.Ints[prop] {
+			f.Type = "int"
+		}
+		if defaultTrue[name+"."+prop] {
+			f.Type = "*bool"
+		}
+		t.Fields = append(t.Fields, f)
+	}
+	sort.SliceStable(t.Fields, func(i, j int) bool { return t.Fields[i].Name < t.Fields[j].Name })
+
+	if f, ok := extensions[name]; ok && g.hasMessages() {
+		t.Fields = append(t.Fields, f)
+	}
+}
+
+// goType returns the Go type of the property schema s of the field in the parent type.
+func (g *Generator) goType(parent, field string, s *Schema) string {
+	if s.Ref != "" {
+		ref := g.resolve(g.lookup(s.Ref))
+		name := g.typeName(refName(s.Ref))
+		switch {
+		case isObject(ref):
+			return "*" + name
+		case isEnum(ref):
+			g.addEnum(name, ref)
+			return name
+		}
+		return g.goType(parent, field, ref)
+	}
+
+	if len(s.OneOf) > 0 {
+		// The alternatives are left to the caller to decode.
+		return "json.RawMessage"
+	}
+	if len(s.Type) != 1 {
+		return "interface{}"
+	}
+	switch s.Type[0] {
+	case "string":
+		if isEnum(s) {
+			name := g.enumName(strings.TrimSuffix(parent, "Body") + field)
+			g.addEnum(name, s)
+			return name
+		}
+		return "string"
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		return "[]" + g.goType(parent, strings.TrimSuffix(field, "s"), s.Items)
+	case "object":
+		switch {
+		case len(s.AdditionalProperties) > 0 && s.AdditionalProperties[0] == '{':
+			return "map[string]string"
+		case string(s.AdditionalProperties) == "true" && len(s.Properties) == 0:
+			return "map[string]interface{}"
+		}
+		name := parent + field
+		if rename, ok := g.Renames[name]; ok {
+			name = rename
+		}
+		g.addType(name, s, "", nil)
+		return "*" + name
+	}
+	return "interface{}"
+}
+
+// enumNames maps the names of the enum types of properties to the names used instead of them
+// to avoid the conflict with other identifiers.
+var enumNames = map[string]string{
+	"ResponseMessage": "ResponseError",
+}
+
+// enumName returns the Go type name of the enum of a property.
+func (g *Generator) enumName(name string) string {
+	if n, ok := enumNames[name]; ok {
+		return n
+	}
+	return name
+}
+
+// addEnum adds the string schema s with the allowed values as the named Go string type.
+func (g *Generator) addEnum(name string, s *Schema) {
+	if _, ok := g.types[name]; ok {
+		return
+	}
+	t := &goType{
+		Name:        name,
+		Description: s.Description,
+	}
+	values := s.Enum
+	if len(s.OpenEnum) > 0 {
+		values = s.OpenEnum
+	} else {
+		t.Closed = true
+	}
+	for i, v := range values {
+		value := &goEnumValue{
+			Name:  name + goName(fmt.Sprint(v)),
+			Value: fmt.Sprint(v),
+		}
+		if i < len(s.EnumDescriptions) {
+			value.Description = s.EnumDescriptions[i]
+		}
+		t.Enum = append(t.Enum, value)
+	}
+	g.types[name] = t
+}
+
+// typeName returns the Go type name of the definition.
+func (g *Generator) typeName(def string) string {
+	if name, ok := g.Renames[def]; ok {
+		return name
+	}
+	return goName(def)
+}
+
+// lookup returns the definition referenced by ref.
+func (g *Generator) lookup(ref string) *Schema {
+	s, ok := g.doc.Definitions[refName(ref)]
+	if !ok {
+		// The references are checked by build.
+		panic(fmt.Sprintf("unknown reference %q", ref))
+	}
+	return s
+}
+
+// resolve flattens the allOf composition of s into a single object schema.
+func (g *Generator) resolve(s *Schema) *Schema {
+	if len(s.AllOf) == 0 {
+		return s
+	}
+	out := &Schema{
+		Type:       TypeList{"object"},
+		Properties: make(map[string]*Schema),
+	}
+	for _, part := range s.AllOf {
+		if part.Ref != "" {
+			part = g.lookup(part.Ref)
+		}
+		part = g.resolve(part)
+		if part.Description != "" {
+			out.Description = part.Description
+		}
+		if summary := messageSummary(part); summary != "" {
+			out.Description = summary + "\n" + out.Description
+		}
+		for name, p := range part.Properties {
+			out.Properties[name] = mergeSchema(out.Properties[name], p)
+		}
+		out.Required = appendUnique(out.Required, part.Required...)
+	}
+	return out
+}
+
+// messageSummary returns the summary line of the request or event schema s.
+func messageSummary(s *Schema) string {
+	if p, ok := s.Properties["command"]; ok && len(p.Enum) == 1 {
+		command := fmt.Sprint(p.Enum[0])
+		return fmt.Sprintf("%s request; value of command field is '%s'.", strings.ToUpper(command[:1])+command[1:], command)
+	}
+	if p, ok := s.Properties["event"]; ok && len(p.Enum) == 1 {
+		return fmt.Sprintf("Event message for '%v' event type.", p.Enum[0])
+	}
+	return ""
+}
+
+// mergeSchema overlays the keywords set in override onto base.
+func mergeSchema(base, override *Schema) *Schema {
+	if base == nil {
+		return override
+	}
+	out := *base
+	if override.Ref != "" {
+		out.Ref = override.Ref
+		out.Type = nil
+		out.Properties = nil
+	}
+	if len(override.Type) > 0 {
+		out.Type = override.Type
+	}
+	if override.Description != "" {
+		out.Description = override.Description
+	}
+	if override.Properties != nil {
+		out.Properties = override.Properties
+		out.Required = override.Required
+	}
+	if override.Items != nil {
+		out.Items = override.Items
+	}
+	if override.Enum != nil {
+		out.Enum = override.Enum
+	}
+	if override.OpenEnum != nil {
+		out.OpenEnum = override.OpenEnum
+		out.EnumDescriptions = override.EnumDescriptions
+	}
+	return &out
+}
+
+// fieldDescription returns the doc comment of the property schema s.
+func fieldDescription(s *Schema) string {
+	desc := s.Description
+	if s.Items != nil && len(s.OpenEnum) == 0 {
+		s = s.Items
+	}
+	if len(s.OpenEnum) == 0 {
+		return desc
+	}
+
+	var b strings.Builder
+	b.WriteString(desc)
+	if desc != "" {
+		b.WriteString("\n")
+	}
+	if len(s.EnumDescriptions) == 0 {
+		b.WriteString("Values: ")
+		for _, v := range s.OpenEnum {
+			fmt.Fprintf(&b, "'%v', ", v)
+		}
+		b.WriteString("etc.")
+		return b.String()
+	}
+	b.WriteString("Values:\n")
+	for i, v := range s.OpenEnum {
+		fmt.Fprintf(&b, "'%v'", v)
+		if i < len(s.EnumDescriptions) {
+			fmt.Fprintf(&b, ": %s", s.EnumDescriptions[i])
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("etc.")
+	return b.String()
+}
+
+// writeComment writes the doc comment text prefixed by name.
+func writeComment(buf *bytes.Buffer, indent, name, text string) {
+	if name == "" && text == "" {
+		return
+	}
+	lines := strings.Split(text, "\n")
+	if name != "" {
+		lines[0] = strings.TrimSpace(name + " " + lines[0])
+	}
+	for _, line := range lines {
+		line = strings.TrimRight(line, " ")
+		if line == "" {
+			fmt.Fprintf(buf, "%s//\n", indent)
+			continue
+		}
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+// writeEnum writes the string type t and its constants.
+func writeEnum(buf *bytes.Buffer, t *goType) {
+	fmt.Fprintf(buf, "type %s string\n\n", t.Name)
+	buf.WriteString("const (\n")
+	for i, v := range t.Enum {
+		if v.Description != "" {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			writeComment(buf, "\t", v.Name, v.Description)
+		}
+		fmt.Fprintf(buf, "\t%s %s = %q\n", v.Name, t.Name, v.Value)
+	}
+	buf.WriteString(")\n")
+}
+
+// writeValid writes the valid method of the closed enum type t used to validate messages.
+func writeValid(buf *bytes.Buffer, t *goType) {
+	fmt.Fprintf(buf, "\n// valid reports whether e is one of the values of %s.\n", t.Name)
+	fmt.Fprintf(buf, "func (e %s) valid() bool {\n\tswitch e {\n\tcase ", t.Name)
+	for i, v := range t.Enum {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.Name)
+	}
+	buf.WriteString(":\n\t\treturn true\n\t}\n\treturn false\n}\n")
+}
+
+// isEnum reports whether s describes a string with the predefined values.
+// The single value enums discriminating the protocol messages are not.
+func isEnum(s *Schema) bool {
+	return s.Type.Is("string") && (len(s.Enum) > 1 || len(s.OpenEnum) > 0)
+}
+
+// isMessage reports whether s describes a protocol message.
+func isMessage(s *Schema) bool {
+	_, seq := s.Properties["seq"]
+	_, typ := s.Properties["type"]
+	return seq && typ
+}
+
+// isObject reports whether s describes an object type.
+func isObject(s *Schema) bool {
+	return s.Type.Is("object") || (len(s.Type) == 0 && s.Properties != nil)
+}
+
+// refName returns the definition name of the ref.
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+// goName converts the JSON name to the exported Go identifier.
+func goName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		switch {
+		case r == '_' || r == '.' || r == '<' || r == '>' || r == ' ' || r == '-':
+			upper = true
+		case upper:
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(dst []string, src ...string) []string {
+	for _, s := range src {
+		found := false
+		for _, d := range dst {
+			if d == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst = append(dst, s)
+		}
+	}
+	return dst
+}
+
+func sortedStrings(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
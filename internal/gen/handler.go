@@ -0,0 +1,182 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// protocolPackage is the import path of the package of the generated protocol types.
+const protocolPackage = "github.com/go-language-server/dap/protocol"
+
+// reverseRequests is the set of the commands of the requests sent by the debug adapter to the client.
+var reverseRequests = map[string]bool{
+	"runInTerminal":  true,
+	"startDebugging": true,
+}
+
+// handlerMethod is a method of the generated Handler interface.
+type handlerMethod struct {
+	Name              string
+	Command           string
+	Request           string
+	Arguments         string
+	ArgumentsRequired bool
+	Response          string
+	Body              string
+}
+
+// params returns the parameter list of the method.
+func (m *handlerMethod) params() string {
+	if m.Arguments == "" {
+		return "ctx context.Context"
+	}
+	return "ctx context.Context, args " + m.Arguments
+}
+
+// results returns the result list of the method.
+func (m *handlerMethod) results() string {
+	if m.Body == "" {
+		return "error"
+	}
+	return "(" + m.Body + ", error)"
+}
+
+// GenerateHandler returns the Go source file of package pkg declaring the Handler interface
+// with a method per request sent by the client, the UnimplementedHandler and the table dispatching
+// the requests to the Handler methods.
+func (g *Generator) GenerateHandler(pkg string) ([]byte, error) {
+	if err := g.build(); err != nil {
+		return nil, err
+	}
+	methods := g.requestMethods()
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n\n\t%q\n)\n", protocolPackage)
+
+	buf.WriteString(`
+// Handler handles the requests sent by the client to the debug adapter.
+//
+// Each method handles the request of the same name and returns the body of its response.
+// The arguments of the requests whose arguments are optional may be nil.
+// The requests missing their required arguments are replied with an error response without calling the Handler.
+// An error returned by a method is sent to the client as an error response.
+//
+// Embed UnimplementedHandler in the implementations to reply with an error response
+// to the requests they do not support.
+type Handler interface {
+`)
+	for i, m := range methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "\t// %s handles the '%s' request, see protocol.%s.\n", m.Name, m.Command, m.Request)
+		fmt.Fprintf(&buf, "\t%s(%s) %s\n", m.Name, m.params(), m.results())
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString(`
+// UnimplementedHandler is a Handler which does not support any request.
+// Its methods return ErrNotImplemented.
+type UnimplementedHandler struct{}
+`)
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\n// %s implements Handler.\n", m.Name)
+		fmt.Fprintf(&buf, "func (UnimplementedHandler) %s(%s) %s {\n", m.Name, m.params(), m.results())
+		if m.Body == "" {
+			buf.WriteString("\treturn ErrNotImplemented\n}\n")
+		} else {
+			buf.WriteString("\treturn nil, ErrNotImplemented\n}\n")
+		}
+	}
+
+	buf.WriteString("\n// handlers maps the command of a request to the function calling the Handler method for it.\n")
+	buf.WriteString("var handlers = map[string]handlerFunc{\n")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%q: func(ctx context.Context, h Handler, req protocol.RequestMessage, resp protocol.Response) (protocol.ResponseMessage, error) {\n", m.Command)
+		call := "h." + m.Name + "(ctx)"
+		switch {
+		case m.ArgumentsRequired:
+			fmt.Fprintf(&buf, "\t\targs := req.(*protocol.%s).Arguments\n", m.Request)
+			buf.WriteString("\t\tif args == nil {\n\t\t\treturn nil, errMissingArguments\n\t\t}\n")
+			call = "h." + m.Name + "(ctx, args)"
+		case m.Arguments != "":
+			call = fmt.Sprintf("h.%s(ctx, req.(*protocol.%s).Arguments)", m.Name, m.Request)
+		}
+		switch {
+		case m.Body != "":
+			fmt.Fprintf(&buf, "\t\tbody, err := %s\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n", call)
+			fmt.Fprintf(&buf, "\t\treturn &protocol.%s{Response: resp, Body: body}, nil\n", m.Response)
+		default:
+			fmt.Fprintf(&buf, "\t\tif err := %s; err != nil {\n\t\t\treturn nil, err\n\t\t}\n", call)
+			if m.Response != "" {
+				fmt.Fprintf(&buf, "\t\treturn &protocol.%s{Response: resp}, nil\n", m.Response)
+			} else {
+				buf.WriteString("\t\treturn &resp, nil\n")
+			}
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	return src, nil
+}
+
+// requestMethods returns the methods of the requests sent by the client to the debug adapter sorted by command.
+func (g *Generator) requestMethods() []*handlerMethod {
+	var methods []*handlerMethod
+	commands := g.enumValues("command", "Request")
+	for _, command := range sortedStrings(commands) {
+		if reverseRequests[command] {
+			continue
+		}
+		m := &handlerMethod{
+			Name:    goName(command),
+			Command: command,
+			Request: commands[command],
+		}
+		for _, f := range g.types[m.Request].Fields {
+			if f.JSONName == "arguments" {
+				m.Arguments = qualify(f.Type)
+				m.ArgumentsRequired = f.Required
+			}
+		}
+		response := strings.TrimSuffix(m.Request, "Request") + "Response"
+		if t, ok := g.types[response]; ok {
+			m.Response = response
+			for _, f := range t.Fields {
+				if f.JSONName == "body" {
+					m.Body = qualify(f.Type)
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+// qualify qualifies the names of the generated types in the Go type t with the protocol package name.
+func qualify(t string) string {
+	name := strings.TrimLeft(t, "*[]")
+	prefix := t[:len(t)-len(name)]
+	if name == "" || strings.ContainsAny(name, ".{") || !isExported(name) {
+		return t
+	}
+	return prefix + "protocol." + name
+}
+
+// isExported reports whether name starts with an upper case letter.
+func isExported(name string) bool {
+	return name[0] >= 'A' && name[0] <= 'Z'
+}
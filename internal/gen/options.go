@@ -0,0 +1,60 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"flag"
+	"strings"
+)
+
+// Options are the options of dapgen selecting the code generated from a Document.
+type Options struct {
+	// Ints is the comma-separated list of property names added to Generator.Ints.
+	Ints string
+
+	// Rename is the comma-separated list of definition=GoName pairs added to Generator.Renames.
+	Rename string
+
+	// Handler selects Generator.GenerateHandler.
+	Handler bool
+
+	// Client selects Generator.GenerateClient.
+	Client bool
+}
+
+// RegisterFlags defines the -int, -rename, -handler and -client flags of the options in fs.
+func (o *Options) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Ints, "int", "", "comma-separated property names whose 'number' type is generated as int")
+	fs.StringVar(&o.Rename, "rename", "", "comma-separated list of definition=GoName renames")
+	fs.BoolVar(&o.Handler, "handler", false, "generate the Handler interface of the requests instead of the types")
+	fs.BoolVar(&o.Client, "client", false, "generate the Client methods sending the requests instead of the types")
+}
+
+// Apply adds the integer properties and the renames of the options to g.
+func (o *Options) Apply(g *Generator) {
+	for _, name := range strings.Split(o.Ints, ",") {
+		if name != "" {
+			g.Ints[name] = true
+		}
+	}
+	for _, r := range strings.Split(o.Rename, ",") {
+		if i := strings.IndexByte(r, '='); i > 0 {
+			g.Renames[r[:i]] = r[i+1:]
+		}
+	}
+}
+
+// Generate returns the Go source file of package pkg generated from doc with the options.
+func (o *Options) Generate(doc *Document, pkg string) ([]byte, error) {
+	g := New(doc)
+	o.Apply(g)
+	switch {
+	case o.Handler:
+		return g.GenerateHandler(pkg)
+	case o.Client:
+		return g.GenerateClient(pkg)
+	}
+	return g.Generate(pkg)
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Schema represents the subset of JSON Schema used by the protocol definitions.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 TypeList           `json:"type,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	OpenEnum             []interface{}      `json:"_enum,omitempty"`
+	EnumDescriptions     []string           `json:"enumDescriptions,omitempty"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+}
+
+// TypeList is the value of the "type" keyword, which is either a single type name or a list of them.
+type TypeList []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TypeList) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = TypeList{s}
+		return nil
+	}
+	var ss []string
+	if err := json.Unmarshal(data, &ss); err != nil {
+		return err
+	}
+	*t = ss
+	return nil
+}
+
+// Is reports whether t is exactly the single type name.
+func (t TypeList) Is(name string) bool {
+	return len(t) == 1 && t[0] == name
+}
+
+// Document is a JSON Schema document with its definitions.
+type Document struct {
+	Definitions map[string]*Schema `json:"definitions"`
+}
+
+// ReadDocument reads the JSON Schema document from the file.
+func ReadDocument(filename string) (*Document, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", filename, err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// MarshalJSON implements json.Marshaler.
+//
+// The implementation specific attributes in Raw are encoded along with the ones defined by the protocol.
+func (a LaunchRequestArguments) MarshalJSON() ([]byte, error) {
+	type arguments LaunchRequestArguments
+	return marshalRaw(arguments(a), a.Raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The whole arguments are kept in Raw.
+func (a *LaunchRequestArguments) UnmarshalJSON(data []byte) error {
+	type arguments LaunchRequestArguments
+	if err := json.Unmarshal(data, (*arguments)(a)); err != nil {
+		return err
+	}
+	a.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// UnmarshalInto decodes the arguments including the implementation specific attributes into v,
+// which is usually a pointer to the launch configuration struct of the debug adapter.
+func (a *LaunchRequestArguments) UnmarshalInto(v interface{}) error {
+	return unmarshalInto(a, v)
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The implementation specific attributes in Raw are encoded along with the ones defined by the protocol.
+func (a AttachRequestArguments) MarshalJSON() ([]byte, error) {
+	type arguments AttachRequestArguments
+	return marshalRaw(arguments(a), a.Raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The whole arguments are kept in Raw.
+func (a *AttachRequestArguments) UnmarshalJSON(data []byte) error {
+	type arguments AttachRequestArguments
+	if err := json.Unmarshal(data, (*arguments)(a)); err != nil {
+		return err
+	}
+	a.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// UnmarshalInto decodes the arguments including the implementation specific attributes into v,
+// which is usually a pointer to the attach configuration struct of the debug adapter.
+func (a *AttachRequestArguments) UnmarshalInto(v interface{}) error {
+	return unmarshalInto(a, v)
+}
+
+// marshalRaw returns the JSON encoding of the struct v with the attributes of the raw JSON object
+// v has no field for. The fields of v take precedence even when they are omitted as empty.
+func marshalRaw(v interface{}, raw json.RawMessage) ([]byte, error) {
+	if len(raw) == 0 {
+		return json.Marshal(v)
+	}
+	var extra map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &extra); err != nil {
+		return nil, err
+	}
+	for name := range jsonFields(reflect.TypeOf(v)) {
+		delete(extra, name)
+	}
+	return marshalExtra(v, extra)
+}
+
+// unmarshalInto decodes the JSON encoding of args into v.
+func unmarshalInto(args json.Marshaler, v interface{}) error {
+	data, err := args.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
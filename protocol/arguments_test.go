@@ -0,0 +1,39 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLaunchRequestArgumentsMarshalJSON(t *testing.T) {
+	var args LaunchRequestArguments
+	if err := json.Unmarshal([]byte(`{"noDebug":true,"__restart":{"port":1},"program":"main.go"}`), &args); err != nil {
+		t.Fatal(err)
+	}
+	args.NoDebug = false
+	args.Restart = nil
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, data, []byte(`{"program":"main.go"}`))
+}
+
+func TestAttachRequestArgumentsMarshalJSON(t *testing.T) {
+	var args AttachRequestArguments
+	if err := json.Unmarshal([]byte(`{"__restart":true,"processId":42}`), &args); err != nil {
+		t.Fatal(err)
+	}
+	args.Restart = nil
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, data, []byte(`{"processId":42}`))
+}
@@ -2,260 +2,266 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package protocol
-
-// Body Event-specific information.
-type Body struct {
-	// If 'allThreadsContinued' is true, a debug adapter can announce that all threads have continued.
-	AllThreadsContinued bool `json:"allThreadsContinued,omitempty"`
+// Code generated by dapgen; DO NOT EDIT.
 
-	// The thread which was continued.
-	ThreadId float64 `json:"threadId,omitempty"`
-}
+package protocol
 
-// AttachRequestArguments Arguments for 'attach' request. Additional attributes are implementation specific.
-type AttachRequestArguments struct {
-	// Optional data from the previous, restarted session.
-	// The data is sent as the 'restart' attribute of the 'terminated' event.
-	// The client should leave the data intact.
-	Restart interface{} `json:"__restart,omitempty"`
-}
+import "encoding/json"
 
 // AttachRequest Attach request; value of command field is 'attach'.
-// The attach request is sent from the client to the debug adapter to attach to a debuggee that is already running. Since attaching is debugger/runtime specific, the arguments for this request are not part of this specification.
+// The `attach` request is sent from the client to the debug adapter to attach to a debuggee that is already running.
+// Since attaching is debugger/runtime specific, the arguments for this request are not part of this specification.
 type AttachRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *AttachRequestArguments `json:"arguments,omitempty"`
+	Arguments *AttachRequestArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *AttachRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// AttachRequestArguments Arguments for `attach` request. Additional attributes are implementation specific.
+type AttachRequestArguments struct {
+	// Arbitrary data from the previous, restarted session.
+	// The data is sent as the `restart` attribute of the `terminated` event.
+	// The client should leave the data intact.
+	Restart interface{} `json:"__restart,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Raw is the JSON encoding of the arguments including the implementation specific attributes.
+	Raw json.RawMessage `json:"-"`
 }
 
-// AttachResponse Response to 'attach' request. This is just an acknowledgement, so no body field is required.
+// AttachResponse Response to `attach` request. This is just an acknowledgement, so no body field is required.
 type AttachResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	Response
 }
 
-// Breakpoint Information about a Breakpoint created in setBreakpoints or setFunctionBreakpoints.
+// Breakpoint Information about a breakpoint created in `setBreakpoints`, `setFunctionBreakpoints`, `setInstructionBreakpoints`, or `setDataBreakpoints` requests.
 type Breakpoint struct {
-	// An optional start column of the actual range covered by the breakpoint.
-	Column float64 `json:"column,omitempty"`
+	// Start position of the source range covered by the breakpoint. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
+
+	// End position of the source range covered by the breakpoint. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	// If no end line is given, then the end column is assumed to be in the start line.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// An optional end column of the actual range covered by the breakpoint. If no end line is given, then the end column is assumed to be in the start line.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// The end line of the actual range covered by the breakpoint.
+	EndLine int `json:"endLine,omitempty"`
 
-	// An optional end line of the actual range covered by the breakpoint.
-	EndLine float64 `json:"endLine,omitempty"`
+	// The identifier for the breakpoint. It is needed if breakpoint events are used to update or remove breakpoints.
+	Id int `json:"id,omitempty"`
 
-	// An optional identifier for the breakpoint. It is needed if breakpoint events are used to update or remove breakpoints.
-	Id float64 `json:"id,omitempty"`
+	// A memory reference to where the breakpoint is set.
+	InstructionReference string `json:"instructionReference,omitempty"`
 
 	// The start line of the actual range covered by the breakpoint.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line,omitempty"`
 
-	// An optional message about the state of the breakpoint. This is shown to the user and can be used to explain why a breakpoint could not be verified.
+	// A message about the state of the breakpoint.
+	// This is shown to the user and can be used to explain why a breakpoint could not be verified.
 	Message string `json:"message,omitempty"`
 
+	// The offset from the instruction reference.
+	// This can be negative.
+	Offset int `json:"offset,omitempty"`
+
+	// A machine-readable explanation of why a breakpoint may not be verified. If a breakpoint is verified or a specific reason is not known, the adapter should omit this property. Possible values include:
+	//
+	// - `pending`: Indicates a breakpoint might be verified in the future, but the adapter cannot verify it in the current state.
+	//  - `failed`: Indicates a breakpoint was not able to be verified, and the adapter does not believe it can be verified without intervention.
+	Reason BreakpointReason `json:"reason,omitempty"`
+
 	// The source where the breakpoint is located.
 	Source *Source `json:"source,omitempty"`
 
-	// If true breakpoint could be set (but not necessarily at the desired location).
-	Verified bool `json:"verified,omitempty"`
+	// If true, the breakpoint could be set (but not necessarily at the desired location).
+	Verified bool `json:"verified"`
 }
 
 // BreakpointEvent Event message for 'breakpoint' event type.
 // The event indicates that some information about a breakpoint has changed.
 type BreakpointEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *BreakpointEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *BreakpointEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// BreakpointEventBody Event-specific information.
+type BreakpointEventBody struct {
+	// The `id` attribute is used to find the target breakpoint, the other attributes are used as the new values.
+	Breakpoint *Breakpoint `json:"breakpoint"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The reason for the event.
+	// Values: 'changed', 'new', 'removed', etc.
+	Reason BreakpointEventReason `json:"reason"`
 }
 
-// BreakpointLocation Properties of a breakpoint location returned from the 'breakpointLocations' request.
+// BreakpointEventReason The reason for the event.
+type BreakpointEventReason string
+
+const (
+	BreakpointEventReasonChanged BreakpointEventReason = "changed"
+	BreakpointEventReasonNew     BreakpointEventReason = "new"
+	BreakpointEventReasonRemoved BreakpointEventReason = "removed"
+)
+
+// BreakpointLocation Properties of a breakpoint location returned from the `breakpointLocations` request.
 type BreakpointLocation struct {
-	// Optional start column of breakpoint location.
-	Column float64 `json:"column,omitempty"`
+	// The start position of a breakpoint location. Position is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
-	// Optional end column of breakpoint location if the location covers a range.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// The end position of a breakpoint location (if the location covers a range). Position is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// Optional end line of breakpoint location if the location covers a range.
-	EndLine float64 `json:"endLine,omitempty"`
+	// The end line of breakpoint location if the location covers a range.
+	EndLine int `json:"endLine,omitempty"`
 
 	// Start line of breakpoint location.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line"`
 }
 
-// BreakpointLocationsArguments Arguments for 'breakpointLocations' request.
+// BreakpointLocationsArguments Arguments for `breakpointLocations` request.
 type BreakpointLocationsArguments struct {
-	// Optional start column of range to search possible breakpoint locations in. If no start column is given, the first column in the start line is assumed.
-	Column float64 `json:"column,omitempty"`
+	// Start position within `line` to search possible breakpoint locations in. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based. If no column is given, the first position in the start line is assumed.
+	Column int `json:"column,omitempty"`
 
-	// Optional end column of range to search possible breakpoint locations in. If no end column is given, then it is assumed to be in the last column of the end line.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// End position within `endLine` to search possible breakpoint locations in. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based. If no end column is given, the last position in the end line is assumed.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// Optional end line of range to search possible breakpoint locations in. If no end line is given, then the end line is assumed to be the start line.
-	EndLine float64 `json:"endLine,omitempty"`
+	// End line of range to search possible breakpoint locations in. If no end line is given, then the end line is assumed to be the start line.
+	EndLine int `json:"endLine,omitempty"`
 
 	// Start line of range to search possible breakpoint locations in. If only the line is specified, the request returns all possible locations in that line.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line"`
 
-	// The source location of the breakpoints; either 'source.path' or 'source.reference' must be specified.
-	Source *Source `json:"source,omitempty"`
+	// The source location of the breakpoints; either `source.path` or `source.sourceReference` must be specified.
+	Source *Source `json:"source"`
 }
 
 // BreakpointLocationsRequest BreakpointLocations request; value of command field is 'breakpointLocations'.
-// The 'breakpointLocations' request returns all possible locations for source breakpoints in a given range.
+// The `breakpointLocations` request returns all possible locations for source breakpoints in a given range.
+// Clients should only call this request if the corresponding capability `supportsBreakpointLocationsRequest` is true.
 type BreakpointLocationsRequest struct {
+	Request
+
 	// Object containing arguments for the command.
 	Arguments *BreakpointLocationsArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
 }
 
-// BreakpointLocationsResponse Response to 'breakpointLocations' request.
+// GetArguments implements RequestMessage.
+func (m *BreakpointLocationsRequest) GetArguments() interface{} { return m.Arguments }
+
+// BreakpointLocationsResponse Response to `breakpointLocations` request.
 // Contains possible locations for source breakpoints.
 type BreakpointLocationsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+	Response
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *BreakpointLocationsResponseBody `json:"body"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *BreakpointLocationsResponse) GetBody() interface{} { return m.Body }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// BreakpointLocationsResponseBody Contains request result if success is true and error details if success is false.
+type BreakpointLocationsResponseBody struct {
+	// Sorted set of possible breakpoint locations.
+	Breakpoints []*BreakpointLocation `json:"breakpoints"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// BreakpointMode A `BreakpointMode` is provided as a option when setting breakpoints on sources or instructions.
+type BreakpointMode struct {
+	// Describes one or more type of breakpoint this mode applies to.
+	AppliesTo []BreakpointModeApplicability `json:"appliesTo"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// A help text providing additional information about the breakpoint mode. This string is typically shown as a hover and can be translated.
+	Description string `json:"description,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+	// The name of the breakpoint mode. This is shown in the UI.
+	Label string `json:"label"`
 
-// CancelArguments Arguments for 'cancel' request.
-type CancelArguments struct {
-	// The ID (attribute 'seq') of the request to cancel.
-	RequestId float64 `json:"requestId,omitempty"`
+	// The internal ID of the mode. This value is passed to the `setBreakpoints` request.
+	Mode string `json:"mode"`
 }
 
-// CancelRequest Cancel request; value of command field is 'cancel'.
-// The 'cancel' request is used by the frontend to indicate that it is no longer interested in the result produced by a specific request issued earlier.
-// This request has a hint characteristic: a debug adapter can only be expected to make a 'best effort' in honouring this request but there are no guarantees.
-// The 'cancel' request may return an error if it could not cancel an operation but a frontend should refrain from presenting this error to end users.
-// A frontend client should only call this request if the capability 'supportsCancelRequest' is true.
-// The request that got canceled still needs to send a response back.
-// This can either be a normal result ('success' attribute true) or an error response ('success' attribute false and the 'message' set to 'cancelled').
-// Returning partial results from a cancelled request is possible but please note that a frontend client has no generic way for detecting that a response is partial or not.
-type CancelRequest struct {
-	// Object containing arguments for the command.
-	Arguments *CancelArguments `json:"arguments,omitempty"`
+// BreakpointModeApplicability Describes one or more type of breakpoint a `BreakpointMode` applies to. This is a non-exhaustive enumeration and may expand as future breakpoint types are added.
+type BreakpointModeApplicability string
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+const (
+	// BreakpointModeApplicabilitySource In `SourceBreakpoint`s
+	BreakpointModeApplicabilitySource BreakpointModeApplicability = "source"
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// BreakpointModeApplicabilityException In exception breakpoints applied in the `ExceptionFilterOptions`
+	BreakpointModeApplicabilityException BreakpointModeApplicability = "exception"
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+	// BreakpointModeApplicabilityData In data breakpoints requested in the `DataBreakpointInfo` request
+	BreakpointModeApplicabilityData BreakpointModeApplicability = "data"
 
-// CancelResponse Response to 'cancel' request. This is just an acknowledgement, so no body field is required.
-type CancelResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+	// BreakpointModeApplicabilityInstruction In `InstructionBreakpoint`s
+	BreakpointModeApplicabilityInstruction BreakpointModeApplicability = "instruction"
+)
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// BreakpointReason A machine-readable explanation of why a breakpoint may not be verified. If a breakpoint is verified or a specific reason is not known, the adapter should omit this property. Possible values include:
+//
+// - `pending`: Indicates a breakpoint might be verified in the future, but the adapter cannot verify it in the current state.
+//   - `failed`: Indicates a breakpoint was not able to be verified, and the adapter does not believe it can be verified without intervention.
+type BreakpointReason string
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+const (
+	BreakpointReasonPending BreakpointReason = "pending"
+	BreakpointReasonFailed  BreakpointReason = "failed"
+)
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// valid reports whether e is one of the values of BreakpointReason.
+func (e BreakpointReason) valid() bool {
+	switch e {
+	case BreakpointReasonPending, BreakpointReasonFailed:
+		return true
+	}
+	return false
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// CancelArguments Arguments for `cancel` request.
+type CancelArguments struct {
+	// The ID (attribute `progressId`) of the progress to cancel. If missing no progress is cancelled.
+	// Both a `requestId` and a `progressId` can be specified in one request.
+	ProgressId string `json:"progressId,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The ID (attribute `seq`) of the request to cancel. If missing no request is cancelled.
+	// Both a `requestId` and a `progressId` can be specified in one request.
+	RequestId int `json:"requestId,omitempty"`
+}
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// CancelRequest Cancel request; value of command field is 'cancel'.
+// The `cancel` request is used by the client in two situations:
+// - to indicate that it is no longer interested in the result produced by a specific request issued earlier
+// - to cancel a progress sequence.
+// Clients should only call this request if the corresponding capability `supportsCancelRequest` is true.
+// This request has a hint characteristic: a debug adapter can only be expected to make a 'best effort' in honoring this request but there are no guarantees.
+// The `cancel` request may return an error if it could not cancel an operation but a client should refrain from presenting this error to end users.
+// The request that got cancelled still needs to send a response back. This can either be a normal result (`success` attribute true) or an error response (`success` attribute false and the `message` set to `cancelled`).
+// Returning partial results from a cancelled request is possible but please note that a client has no generic way for detecting that a response is partial or not.
+// The progress that got cancelled still needs to send a `progressEnd` event back.
+//
+//	A client should not assume that progress just got cancelled after sending the `cancel` request.
+type CancelRequest struct {
+	Request
+
+	// Object containing arguments for the command.
+	Arguments *CancelArguments `json:"arguments,omitempty"`
+}
+
+// GetArguments implements RequestMessage.
+func (m *CancelRequest) GetArguments() interface{} { return m.Arguments }
+
+// CancelResponse Response to `cancel` request. This is just an acknowledgement, so no body field is required.
+type CancelResponse struct {
+	Response
 }
 
 // Capabilities Information about the capabilities of a debug adapter.
@@ -263,448 +269,526 @@ type Capabilities struct {
 	// The set of additional module information exposed by the debug adapter.
 	AdditionalModuleColumns []*ColumnDescriptor `json:"additionalModuleColumns,omitempty"`
 
-	// The set of characters that should trigger completion in a REPL. If not specified, the UI should assume the '.' character.
+	// Modes of breakpoints supported by the debug adapter, such as 'hardware' or 'software'. If present, the client may allow the user to select a mode and include it in its `setBreakpoints` request.
+	//
+	// Clients may present the first applicable mode in this array as the 'default' mode in gestures that set breakpoints.
+	BreakpointModes []*BreakpointMode `json:"breakpointModes,omitempty"`
+
+	// The set of characters that should trigger completion in a REPL. If not specified, the UI should assume the `.` character.
 	CompletionTriggerCharacters []string `json:"completionTriggerCharacters,omitempty"`
 
-	// Available filters or options for the setExceptionBreakpoints request.
+	// Available exception filter options for the `setExceptionBreakpoints` request.
 	ExceptionBreakpointFilters []*ExceptionBreakpointsFilter `json:"exceptionBreakpointFilters,omitempty"`
 
-	// The debug adapter supports the 'terminateDebuggee' attribute on the 'disconnect' request.
+	// The debug adapter supports the `suspendDebuggee` attribute on the `disconnect` request.
+	SupportSuspendDebuggee bool `json:"supportSuspendDebuggee,omitempty"`
+
+	// The debug adapter supports the `terminateDebuggee` attribute on the `disconnect` request.
 	SupportTerminateDebuggee bool `json:"supportTerminateDebuggee,omitempty"`
 
 	// Checksum algorithms supported by the debug adapter.
-	SupportedChecksumAlgorithms []string `json:"supportedChecksumAlgorithms,omitempty"`
+	SupportedChecksumAlgorithms []ChecksumAlgorithm `json:"supportedChecksumAlgorithms,omitempty"`
 
-	// The debug adapter supports the 'breakpointLocations' request.
+	// The debug adapter supports ANSI escape sequences in styling of `OutputEvent.output` and `Variable.value` fields.
+	SupportsANSIStyling bool `json:"supportsANSIStyling,omitempty"`
+
+	// The debug adapter supports the `breakpointLocations` request.
 	SupportsBreakpointLocationsRequest bool `json:"supportsBreakpointLocationsRequest,omitempty"`
 
-	// The debug adapter supports the 'cancel' request.
+	// The debug adapter supports the `cancel` request.
 	SupportsCancelRequest bool `json:"supportsCancelRequest,omitempty"`
 
-	// The debug adapter supports the 'completions' request.
+	// The debug adapter supports the `clipboard` context value in the `evaluate` request.
+	SupportsClipboardContext bool `json:"supportsClipboardContext,omitempty"`
+
+	// The debug adapter supports the `completions` request.
 	SupportsCompletionsRequest bool `json:"supportsCompletionsRequest,omitempty"`
 
 	// The debug adapter supports conditional breakpoints.
 	SupportsConditionalBreakpoints bool `json:"supportsConditionalBreakpoints,omitempty"`
 
-	// The debug adapter supports the 'configurationDone' request.
+	// The debug adapter supports the `configurationDone` request.
 	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest,omitempty"`
 
+	// The debug adapter supports the `asAddress` and `bytes` fields in the `dataBreakpointInfo` request.
+	SupportsDataBreakpointBytes bool `json:"supportsDataBreakpointBytes,omitempty"`
+
 	// The debug adapter supports data breakpoints.
 	SupportsDataBreakpoints bool `json:"supportsDataBreakpoints,omitempty"`
 
-	// The debug adapter supports the delayed loading of parts of the stack, which requires that both the 'startFrame' and 'levels' arguments and the 'totalFrames' result of the 'StackTrace' request are supported.
+	// The debug adapter supports the delayed loading of parts of the stack, which requires that both the `startFrame` and `levels` arguments and the `totalFrames` result of the `stackTrace` request are supported.
 	SupportsDelayedStackTraceLoading bool `json:"supportsDelayedStackTraceLoading,omitempty"`
 
-	// The debug adapter supports the 'disassemble' request.
+	// The debug adapter supports the `disassemble` request.
 	SupportsDisassembleRequest bool `json:"supportsDisassembleRequest,omitempty"`
 
-	// The debug adapter supports a (side effect free) evaluate request for data hovers.
+	// The debug adapter supports a (side effect free) `evaluate` request for data hovers.
 	SupportsEvaluateForHovers bool `json:"supportsEvaluateForHovers,omitempty"`
 
-	// The debug adapter supports the 'exceptionInfo' request.
+	// The debug adapter supports `filterOptions` as an argument on the `setExceptionBreakpoints` request.
+	SupportsExceptionFilterOptions bool `json:"supportsExceptionFilterOptions,omitempty"`
+
+	// The debug adapter supports the `exceptionInfo` request.
 	SupportsExceptionInfoRequest bool `json:"supportsExceptionInfoRequest,omitempty"`
 
-	// The debug adapter supports 'exceptionOptions' on the setExceptionBreakpoints request.
+	// The debug adapter supports `exceptionOptions` on the `setExceptionBreakpoints` request.
 	SupportsExceptionOptions bool `json:"supportsExceptionOptions,omitempty"`
 
 	// The debug adapter supports function breakpoints.
 	SupportsFunctionBreakpoints bool `json:"supportsFunctionBreakpoints,omitempty"`
 
-	// The debug adapter supports the 'gotoTargets' request.
+	// The debug adapter supports the `gotoTargets` request.
 	SupportsGotoTargetsRequest bool `json:"supportsGotoTargetsRequest,omitempty"`
 
 	// The debug adapter supports breakpoints that break execution after a specified number of hits.
 	SupportsHitConditionalBreakpoints bool `json:"supportsHitConditionalBreakpoints,omitempty"`
 
-	// The debug adapter supports the 'loadedSources' request.
+	// The debug adapter supports adding breakpoints based on instruction references.
+	SupportsInstructionBreakpoints bool `json:"supportsInstructionBreakpoints,omitempty"`
+
+	// The debug adapter supports the `loadedSources` request.
 	SupportsLoadedSourcesRequest bool `json:"supportsLoadedSourcesRequest,omitempty"`
 
-	// The debug adapter supports logpoints by interpreting the 'logMessage' attribute of the SourceBreakpoint.
+	// The debug adapter supports log points by interpreting the `logMessage` attribute of the `SourceBreakpoint`.
 	SupportsLogPoints bool `json:"supportsLogPoints,omitempty"`
 
-	// The debug adapter supports the 'modules' request.
+	// The debug adapter supports the `modules` request.
 	SupportsModulesRequest bool `json:"supportsModulesRequest,omitempty"`
 
-	// The debug adapter supports the 'readMemory' request.
+	// The debug adapter supports the `readMemory` request.
 	SupportsReadMemoryRequest bool `json:"supportsReadMemoryRequest,omitempty"`
 
 	// The debug adapter supports restarting a frame.
 	SupportsRestartFrame bool `json:"supportsRestartFrame,omitempty"`
 
-	// The debug adapter supports the 'restart' request. In this case a client should not implement 'restart' by terminating and relaunching the adapter but by calling the RestartRequest.
+	// The debug adapter supports the `restart` request. In this case a client should not implement `restart` by terminating and relaunching the adapter but by calling the `restart` request.
 	SupportsRestartRequest bool `json:"supportsRestartRequest,omitempty"`
 
-	// The debug adapter supports the 'setExpression' request.
+	// The debug adapter supports the `setExpression` request.
 	SupportsSetExpression bool `json:"supportsSetExpression,omitempty"`
 
 	// The debug adapter supports setting a variable to a value.
 	SupportsSetVariable bool `json:"supportsSetVariable,omitempty"`
 
-	// The debug adapter supports stepping back via the 'stepBack' and 'reverseContinue' requests.
+	// The debug adapter supports the `singleThread` property on the execution requests (`continue`, `next`, `stepIn`, `stepOut`, `reverseContinue`, `stepBack`).
+	SupportsSingleThreadExecutionRequests bool `json:"supportsSingleThreadExecutionRequests,omitempty"`
+
+	// The debug adapter supports stepping back via the `stepBack` and `reverseContinue` requests.
 	SupportsStepBack bool `json:"supportsStepBack,omitempty"`
 
-	// The debug adapter supports the 'stepInTargets' request.
+	// The debug adapter supports the `stepInTargets` request.
 	SupportsStepInTargetsRequest bool `json:"supportsStepInTargetsRequest,omitempty"`
 
-	// The debug adapter supports the 'terminate' request.
+	// The debug adapter supports stepping granularities (argument `granularity`) for the stepping requests.
+	SupportsSteppingGranularity bool `json:"supportsSteppingGranularity,omitempty"`
+
+	// The debug adapter supports the `terminate` request.
 	SupportsTerminateRequest bool `json:"supportsTerminateRequest,omitempty"`
 
-	// The debug adapter supports the 'terminateThreads' request.
+	// The debug adapter supports the `terminateThreads` request.
 	SupportsTerminateThreadsRequest bool `json:"supportsTerminateThreadsRequest,omitempty"`
 
-	// The debug adapter supports a 'format' attribute on the stackTraceRequest, variablesRequest, and evaluateRequest.
+	// The debug adapter supports a `format` attribute on the `stackTrace`, `variables`, and `evaluate` requests.
 	SupportsValueFormattingOptions bool `json:"supportsValueFormattingOptions,omitempty"`
+
+	// The debug adapter supports the `writeMemory` request.
+	SupportsWriteMemoryRequest bool `json:"supportsWriteMemoryRequest,omitempty"`
 }
 
 // CapabilitiesEvent Event message for 'capabilities' event type.
 // The event indicates that one or more capabilities have changed.
-// Since the capabilities are dependent on the frontend and its UI, it might not be possible to change that at random times (or too late).
-// Consequently this event has a hint characteristic: a frontend can only be expected to make a 'best effort' in honouring individual capabilities but there are no guarantees.
+// Since the capabilities are dependent on the client and its UI, it might not be possible to change that at random times (or too late).
+// Consequently this event has a hint characteristic: a client can only be expected to make a 'best effort' in honoring individual capabilities but there are no guarantees.
 // Only changed capabilities need to be included, all other capabilities keep their values.
 type CapabilitiesEvent struct {
-	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Event
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+	// Event-specific information.
+	Body *CapabilitiesEventBody `json:"body"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetBody implements EventMessage.
+func (m *CapabilitiesEvent) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// CapabilitiesEventBody Event-specific information.
+type CapabilitiesEventBody struct {
+	// The set of updated capabilities.
+	Capabilities *Capabilities `json:"capabilities"`
 }
 
 // Checksum The checksum of an item calculated by the specified algorithm.
 type Checksum struct {
 	// The algorithm used to calculate this checksum.
-	Algorithm string `json:"algorithm,omitempty"`
+	Algorithm ChecksumAlgorithm `json:"algorithm"`
+
+	// Value of the checksum, encoded as a hexadecimal value.
+	Checksum string `json:"checksum"`
+}
+
+// ChecksumAlgorithm Names of checksum algorithms that may be supported by a debug adapter.
+type ChecksumAlgorithm string
 
-	// Value of the checksum.
-	Checksum string `json:"checksum,omitempty"`
+const (
+	ChecksumAlgorithmMD5       ChecksumAlgorithm = "MD5"
+	ChecksumAlgorithmSHA1      ChecksumAlgorithm = "SHA1"
+	ChecksumAlgorithmSHA256    ChecksumAlgorithm = "SHA256"
+	ChecksumAlgorithmTimestamp ChecksumAlgorithm = "timestamp"
+)
+
+// valid reports whether e is one of the values of ChecksumAlgorithm.
+func (e ChecksumAlgorithm) valid() bool {
+	switch e {
+	case ChecksumAlgorithmMD5, ChecksumAlgorithmSHA1, ChecksumAlgorithmSHA256, ChecksumAlgorithmTimestamp:
+		return true
+	}
+	return false
 }
 
-// ColumnDescriptor A ColumnDescriptor specifies what module attribute to show in a column of the ModulesView, how to format it, and what the column's label should be.
+// ColumnDescriptor A `ColumnDescriptor` specifies what module attribute to show in a column of the modules view, how to format it,
+// and what the column's label should be.
 // It is only used if the underlying UI actually supports this level of customization.
 type ColumnDescriptor struct {
 	// Name of the attribute rendered in this column.
-	AttributeName string `json:"attributeName,omitempty"`
+	AttributeName string `json:"attributeName"`
 
 	// Format to use for the rendered values in this column. TBD how the format strings looks like.
 	Format string `json:"format,omitempty"`
 
 	// Header UI label of column.
-	Label string `json:"label,omitempty"`
+	Label string `json:"label"`
 
-	// Datatype of values in this column.  Defaults to 'string' if not specified.
-	Type string `json:"type,omitempty"`
+	// Datatype of values in this column. Defaults to `string` if not specified.
+	Type ColumnDescriptorType `json:"type,omitempty"`
 
 	// Width of this column in characters (hint only).
-	Width float64 `json:"width,omitempty"`
+	Width int `json:"width,omitempty"`
 }
 
-// CompletionItem CompletionItems are the suggestions returned from the CompletionsRequest.
+// ColumnDescriptorType Datatype of values in this column. Defaults to `string` if not specified.
+type ColumnDescriptorType string
+
+const (
+	ColumnDescriptorTypeString           ColumnDescriptorType = "string"
+	ColumnDescriptorTypeNumber           ColumnDescriptorType = "number"
+	ColumnDescriptorTypeBoolean          ColumnDescriptorType = "boolean"
+	ColumnDescriptorTypeUnixTimestampUTC ColumnDescriptorType = "unixTimestampUTC"
+)
+
+// valid reports whether e is one of the values of ColumnDescriptorType.
+func (e ColumnDescriptorType) valid() bool {
+	switch e {
+	case ColumnDescriptorTypeString, ColumnDescriptorTypeNumber, ColumnDescriptorTypeBoolean, ColumnDescriptorTypeUnixTimestampUTC:
+		return true
+	}
+	return false
+}
+
+// CompletionItem `CompletionItems` are the suggestions returned from the `completions` request.
 type CompletionItem struct {
+	// A human-readable string with additional information about this item, like type or symbol information.
+	Detail string `json:"detail,omitempty"`
+
 	// The label of this completion item. By default this is also the text that is inserted when selecting this completion.
-	Label string `json:"label,omitempty"`
+	Label string `json:"label"`
+
+	// Length determines how many characters are overwritten by the completion text and it is measured in UTF-16 code units. If missing the value 0 is assumed which results in the completion text being inserted.
+	Length int `json:"length,omitempty"`
 
-	// This value determines how many characters are overwritten by the completion text.
-	// If missing the value 0 is assumed which results in the completion text being inserted.
-	Length float64 `json:"length,omitempty"`
+	// Determines the length of the new selection after the text has been inserted (or replaced) and it is measured in UTF-16 code units. The selection can not extend beyond the bounds of the completion text. If omitted the length is assumed to be 0.
+	SelectionLength int `json:"selectionLength,omitempty"`
 
-	// A string that should be used when comparing this item with other items. When `falsy` the label is used.
+	// Determines the start of the new selection after the text has been inserted (or replaced). `selectionStart` is measured in UTF-16 code units and must be in the range 0 and length of the completion text. If omitted the selection starts at the end of the completion text.
+	SelectionStart int `json:"selectionStart,omitempty"`
+
+	// A string that should be used when comparing this item with other items. If not returned or an empty string, the `label` is used instead.
 	SortText string `json:"sortText,omitempty"`
 
-	// This value determines the location (in the CompletionsRequest's 'text' attribute) where the completion text is added.
-	// If missing the text is added at the location specified by the CompletionsRequest's 'column' attribute.
-	Start float64 `json:"start,omitempty"`
+	// Start position (within the `text` attribute of the `completions` request) where the completion text is added. The position is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based. If the start position is omitted the text is added at the location specified by the `column` attribute of the `completions` request.
+	Start int `json:"start,omitempty"`
 
-	// If text is not falsy then it is inserted instead of the label.
+	// If text is returned and not an empty string, then it is inserted instead of the label.
 	Text string `json:"text,omitempty"`
 
 	// The item's type. Typically the client uses this information to render the item in the UI with an icon.
-	Type string `json:"type,omitempty"`
-}
-
-// CompletionsArguments Arguments for 'completions' request.
+	Type CompletionItemType `json:"type,omitempty"`
+}
+
+// CompletionItemType Some predefined types for the CompletionItem. Please note that not all clients have specific icons for all of them.
+type CompletionItemType string
+
+const (
+	CompletionItemTypeMethod      CompletionItemType = "method"
+	CompletionItemTypeFunction    CompletionItemType = "function"
+	CompletionItemTypeConstructor CompletionItemType = "constructor"
+	CompletionItemTypeField       CompletionItemType = "field"
+	CompletionItemTypeVariable    CompletionItemType = "variable"
+	CompletionItemTypeClass       CompletionItemType = "class"
+	CompletionItemTypeInterface   CompletionItemType = "interface"
+	CompletionItemTypeModule      CompletionItemType = "module"
+	CompletionItemTypeProperty    CompletionItemType = "property"
+	CompletionItemTypeUnit        CompletionItemType = "unit"
+	CompletionItemTypeValue       CompletionItemType = "value"
+	CompletionItemTypeEnum        CompletionItemType = "enum"
+	CompletionItemTypeKeyword     CompletionItemType = "keyword"
+	CompletionItemTypeSnippet     CompletionItemType = "snippet"
+	CompletionItemTypeText        CompletionItemType = "text"
+	CompletionItemTypeColor       CompletionItemType = "color"
+	CompletionItemTypeFile        CompletionItemType = "file"
+	CompletionItemTypeReference   CompletionItemType = "reference"
+	CompletionItemTypeCustomcolor CompletionItemType = "customcolor"
+)
+
+// valid reports whether e is one of the values of CompletionItemType.
+func (e CompletionItemType) valid() bool {
+	switch e {
+	case CompletionItemTypeMethod, CompletionItemTypeFunction, CompletionItemTypeConstructor, CompletionItemTypeField, CompletionItemTypeVariable, CompletionItemTypeClass, CompletionItemTypeInterface, CompletionItemTypeModule, CompletionItemTypeProperty, CompletionItemTypeUnit, CompletionItemTypeValue, CompletionItemTypeEnum, CompletionItemTypeKeyword, CompletionItemTypeSnippet, CompletionItemTypeText, CompletionItemTypeColor, CompletionItemTypeFile, CompletionItemTypeReference, CompletionItemTypeCustomcolor:
+		return true
+	}
+	return false
+}
+
+// CompletionsArguments Arguments for `completions` request.
 type CompletionsArguments struct {
-	// The character position for which to determine the completion proposals.
-	Column float64 `json:"column,omitempty"`
+	// The position within `text` for which to determine the completion proposals. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column"`
 
 	// Returns completions in the scope of this stack frame. If not specified, the completions are returned for the global scope.
-	FrameId float64 `json:"frameId,omitempty"`
+	FrameId int `json:"frameId,omitempty"`
 
-	// An optional line for which to determine the completion proposals. If missing the first line of the text is assumed.
-	Line float64 `json:"line,omitempty"`
+	// A line for which to determine the completion proposals. If missing the first line of the text is assumed.
+	Line int `json:"line,omitempty"`
 
-	// One or more source lines. Typically this is the text a user has typed into the debug console before he asked for completion.
-	Text string `json:"text,omitempty"`
+	// One or more source lines. Typically this is the text users have typed into the debug console before they asked for completion.
+	Text string `json:"text"`
 }
 
 // CompletionsRequest Completions request; value of command field is 'completions'.
 // Returns a list of possible completions for a given caret position and text.
-// The CompletionsRequest may only be called if the 'supportsCompletionsRequest' capability exists and is true.
+// Clients should only call this request if the corresponding capability `supportsCompletionsRequest` is true.
 type CompletionsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *CompletionsArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *CompletionsArguments `json:"arguments"`
 }
 
-// CompletionsResponse Response to 'completions' request.
-type CompletionsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *CompletionsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// CompletionsResponse Response to `completions` request.
+type CompletionsResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *CompletionsResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *CompletionsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// CompletionsResponseBody Contains request result if success is true and error details if success is false.
+type CompletionsResponseBody struct {
+	// The possible completions for .
+	Targets []*CompletionItem `json:"targets"`
 }
 
-// ConfigurationDoneArguments Arguments for 'configurationDone' request.
+// ConfigurationDoneArguments Arguments for `configurationDone` request.
 type ConfigurationDoneArguments struct{}
 
 // ConfigurationDoneRequest ConfigurationDone request; value of command field is 'configurationDone'.
-// The client of the debug protocol must send this request at the end of the sequence of configuration requests (which was started by the 'initialized' event).
+// This request indicates that the client has finished initialization of the debug adapter.
+// So it is the last request in the sequence of configuration requests (which was started by the `initialized` event).
+// Clients should only call this request if the corresponding capability `supportsConfigurationDoneRequest` is true.
 type ConfigurationDoneRequest struct {
+	Request
+
 	// Object containing arguments for the command.
 	Arguments *ConfigurationDoneArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
 }
 
-// ConfigurationDoneResponse Response to 'configurationDone' request. This is just an acknowledgement, so no body field is required.
-type ConfigurationDoneResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ConfigurationDoneRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ConfigurationDoneResponse Response to `configurationDone` request. This is just an acknowledgement, so no body field is required.
+type ConfigurationDoneResponse struct {
+	Response
 }
 
-// ContinueArguments Arguments for 'continue' request.
+// ContinueArguments Arguments for `continue` request.
 type ContinueArguments struct {
-	// Continue execution for the specified thread (if possible). If the backend cannot continue on a single thread but will continue on all threads, it should set the 'allThreadsContinued' attribute in the response to true.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// If this flag is true, execution is resumed only for the thread with given `threadId`.
+	SingleThread bool `json:"singleThread,omitempty"`
+
+	// Specifies the active thread. If the debug adapter supports single thread execution (see `supportsSingleThreadExecutionRequests`) and the argument `singleThread` is true, only the thread with this ID is resumed.
+	ThreadId int `json:"threadId"`
 }
 
 // ContinueRequest Continue request; value of command field is 'continue'.
-// The request starts the debuggee to run again.
+// The request resumes execution of all threads. If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true resumes only the specified thread. If not all threads were resumed, the `allThreadsContinued` attribute of the response should be set to false.
 type ContinueRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ContinueArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ContinueArguments `json:"arguments"`
 }
 
-// ContinueResponse Response to 'continue' request.
-type ContinueResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ContinueRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// ContinueResponse Response to `continue` request.
+type ContinueResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ContinueResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ContinueResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ContinueResponseBody Contains request result if success is true and error details if success is false.
+type ContinueResponseBody struct {
+	// The value true (or a missing property) signals to the client that all threads have been resumed. The value false indicates that not all threads were resumed.
+	AllThreadsContinued *bool `json:"allThreadsContinued,omitempty"`
 }
 
 // ContinuedEvent Event message for 'continued' event type.
 // The event indicates that the execution of the debuggee has continued.
-// Please note: a debug adapter is not expected to send this event in response to a request that implies that execution continues, e.g. 'launch' or 'continue'.
-// It is only necessary to send a 'continued' event if there was no previous request that implied this.
+// Please note: a debug adapter is not expected to send this event in response to a request that implies that execution continues, e.g. `launch` or `continue`.
+// It is only necessary to send a `continued` event if there was no previous request that implied this.
 type ContinuedEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ContinuedEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *ContinuedEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ContinuedEventBody Event-specific information.
+type ContinuedEventBody struct {
+	// If `allThreadsContinued` is true, a debug adapter can announce that all threads have continued.
+	AllThreadsContinued bool `json:"allThreadsContinued,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The thread which was continued.
+	ThreadId int `json:"threadId"`
 }
 
-// DataBreakpoint Properties of a data breakpoint passed to the setDataBreakpoints request.
+// DataBreakpoint Properties of a data breakpoint passed to the `setDataBreakpoints` request.
 type DataBreakpoint struct {
 	// The access type of the data.
-	AccessType string `json:"accessType,omitempty"`
+	AccessType DataBreakpointAccessType `json:"accessType,omitempty"`
 
-	// An optional expression for conditional breakpoints.
+	// An expression for conditional breakpoints.
 	Condition string `json:"condition,omitempty"`
 
-	// An id representing the data. This id is returned from the dataBreakpointInfo request.
-	DataId string `json:"dataId,omitempty"`
+	// An id representing the data. This id is returned from the `dataBreakpointInfo` request.
+	DataId string `json:"dataId"`
 
-	// An optional expression that controls how many hits of the breakpoint are ignored. The backend is expected to interpret the expression as needed.
+	// An expression that controls how many hits of the breakpoint are ignored.
+	// The debug adapter is expected to interpret the expression as needed.
 	HitCondition string `json:"hitCondition,omitempty"`
 }
 
-// DataBreakpointInfoArguments Arguments for 'dataBreakpointInfo' request.
+// DataBreakpointAccessType This enumeration defines all possible access types for data breakpoints.
+type DataBreakpointAccessType string
+
+const (
+	DataBreakpointAccessTypeRead      DataBreakpointAccessType = "read"
+	DataBreakpointAccessTypeWrite     DataBreakpointAccessType = "write"
+	DataBreakpointAccessTypeReadWrite DataBreakpointAccessType = "readWrite"
+)
+
+// valid reports whether e is one of the values of DataBreakpointAccessType.
+func (e DataBreakpointAccessType) valid() bool {
+	switch e {
+	case DataBreakpointAccessTypeRead, DataBreakpointAccessTypeWrite, DataBreakpointAccessTypeReadWrite:
+		return true
+	}
+	return false
+}
+
+// DataBreakpointInfoArguments Arguments for `dataBreakpointInfo` request.
 type DataBreakpointInfoArguments struct {
-	// The name of the Variable's child to obtain data breakpoint information for. If variableReference isn’t provided, this can be an expression.
-	Name string `json:"name,omitempty"`
+	// If `true`, the `name` is a memory address and the debugger should interpret it as a decimal value, or hex value if it is prefixed with `0x`.
+	//
+	// Clients may set this property only if the `supportsDataBreakpointBytes`
+	// capability is true.
+	AsAddress bool `json:"asAddress,omitempty"`
+
+	// If specified, a debug adapter should return information for the range of memory extending `bytes` number of bytes from the address or variable specified by `name`. Breakpoints set using the resulting data ID should pause on data access anywhere within that range.
+	//
+	// Clients may set this property only if the `supportsDataBreakpointBytes` capability is true.
+	Bytes int `json:"bytes,omitempty"`
+
+	// When `name` is an expression, evaluate it in the scope of this stack frame. If not specified, the expression is evaluated in the global scope. When `variablesReference` is specified, this property has no effect.
+	FrameId int `json:"frameId,omitempty"`
 
-	// Reference to the Variable container if the data breakpoint is requested for a child of the container.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	// The mode of the desired breakpoint. If defined, this must be one of the `breakpointModes` the debug adapter advertised in its `Capabilities`.
+	Mode string `json:"mode,omitempty"`
+
+	// The name of the variable's child to obtain data breakpoint information for.
+	// If `variablesReference` isn't specified, this can be an expression, or an address if `asAddress` is also true.
+	Name string `json:"name"`
+
+	// Reference to the variable container if the data breakpoint is requested for a child of the container. The `variablesReference` must have been obtained in the current suspended state. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
 // DataBreakpointInfoRequest DataBreakpointInfo request; value of command field is 'dataBreakpointInfo'.
 // Obtains information on a possible data breakpoint that could be set on an expression or variable.
+// Clients should only call this request if the corresponding capability `supportsDataBreakpoints` is true.
 type DataBreakpointInfoRequest struct {
-	// Object containing arguments for the command.
-	Arguments *DataBreakpointInfoArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *DataBreakpointInfoArguments `json:"arguments"`
 }
 
-// DataBreakpointInfoResponse Response to 'dataBreakpointInfo' request.
+// GetArguments implements RequestMessage.
+func (m *DataBreakpointInfoRequest) GetArguments() interface{} { return m.Arguments }
+
+// DataBreakpointInfoResponse Response to `dataBreakpointInfo` request.
 type DataBreakpointInfoResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+	Response
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *DataBreakpointInfoResponseBody `json:"body"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *DataBreakpointInfoResponse) GetBody() interface{} { return m.Body }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// DataBreakpointInfoResponseBody Contains request result if success is true and error details if success is false.
+type DataBreakpointInfoResponseBody struct {
+	// Attribute lists the available access types for a potential data breakpoint. A UI client could surface this information.
+	AccessTypes []DataBreakpointAccessType `json:"accessTypes,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Attribute indicates that a potential data breakpoint could be persisted across sessions.
+	CanPersist bool `json:"canPersist,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// An identifier for the data on which a data breakpoint can be registered with the `setDataBreakpoints` request or null if no data breakpoint is available. If a `variablesReference` or `frameId` is passed, the `dataId` is valid in the current suspended state, otherwise it's valid indefinitely. See 'Lifetime of Object References' in the Overview section for details. Breakpoints set using the `dataId` in the `setDataBreakpoints` request may outlive the lifetime of the associated `dataId`.
+	DataId interface{} `json:"dataId"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// UI string that describes on what data the breakpoint is set on or why a data breakpoint is not available.
+	Description string `json:"description"`
 }
 
-// DisassembleArguments Arguments for 'disassemble' request.
+// DisassembleArguments Arguments for `disassemble` request.
 type DisassembleArguments struct {
-	// Number of instructions to disassemble starting at the specified location and offset. An adapter must return exactly this number of instructions - any unavailable instructions should be replaced with an implementation-defined 'invalid instruction' value.
-	InstructionCount float64 `json:"instructionCount,omitempty"`
+	// Number of instructions to disassemble starting at the specified location and offset.
+	// An adapter must return exactly this number of instructions - any unavailable instructions should be replaced with an implementation-defined 'invalid instruction' value.
+	InstructionCount int `json:"instructionCount"`
 
-	// Optional offset (in instructions) to be applied after the byte offset (if any) before disassembling. Can be negative.
-	InstructionOffset float64 `json:"instructionOffset,omitempty"`
+	// Offset (in instructions) to be applied after the byte offset (if any) before disassembling. Can be negative.
+	InstructionOffset int `json:"instructionOffset,omitempty"`
 
 	// Memory reference to the base location containing the instructions to disassemble.
-	MemoryReference string `json:"memoryReference,omitempty"`
+	MemoryReference string `json:"memoryReference"`
 
-	// Optional offset (in bytes) to be applied to the reference location before disassembling. Can be negative.
-	Offset float64 `json:"offset,omitempty"`
+	// Offset (in bytes) to be applied to the reference location before disassembling. Can be negative.
+	Offset int `json:"offset,omitempty"`
 
 	// If true, the adapter should attempt to resolve memory addresses and other values to symbolic names.
 	ResolveSymbols bool `json:"resolveSymbols,omitempty"`
@@ -712,276 +796,343 @@ type DisassembleArguments struct {
 
 // DisassembleRequest Disassemble request; value of command field is 'disassemble'.
 // Disassembles code stored at the provided location.
+// Clients should only call this request if the corresponding capability `supportsDisassembleRequest` is true.
 type DisassembleRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *DisassembleArguments `json:"arguments,omitempty"`
+	Arguments *DisassembleArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *DisassembleRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// DisassembleResponse Response to `disassemble` request.
+type DisassembleResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *DisassembleResponseBody `json:"body,omitempty"`
 }
 
-// DisassembleResponse Response to 'disassemble' request.
-type DisassembleResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *DisassembleResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// DisassembleResponseBody Contains request result if success is true and error details if success is false.
+type DisassembleResponseBody struct {
+	// The list of disassembled instructions.
+	Instructions []*DisassembledInstruction `json:"instructions"`
 }
 
 // DisassembledInstruction Represents a single disassembled instruction.
 type DisassembledInstruction struct {
-	// The address of the instruction. Treated as a hex value if prefixed with '0x', or as a decimal value otherwise.
-	Address string `json:"address,omitempty"`
+	// The address of the instruction. Treated as a hex value if prefixed with `0x`, or as a decimal value otherwise.
+	Address string `json:"address"`
 
 	// The column within the line that corresponds to this instruction, if any.
-	Column float64 `json:"column,omitempty"`
+	Column int `json:"column,omitempty"`
 
 	// The end column of the range that corresponds to this instruction, if any.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	EndColumn int `json:"endColumn,omitempty"`
 
 	// The end line of the range that corresponds to this instruction, if any.
-	EndLine float64 `json:"endLine,omitempty"`
+	EndLine int `json:"endLine,omitempty"`
 
 	// Text representing the instruction and its operands, in an implementation-defined format.
-	Instruction string `json:"instruction,omitempty"`
+	Instruction string `json:"instruction"`
 
-	// Optional raw bytes representing the instruction and its operands, in an implementation-defined format.
+	// Raw bytes representing the instruction and its operands, in an implementation-defined format.
 	InstructionBytes string `json:"instructionBytes,omitempty"`
 
 	// The line within the source location that corresponds to this instruction, if any.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line,omitempty"`
 
-	// Source location that corresponds to this instruction, if any. Should always be set (if available) on the first instruction returned, but can be omitted afterwards if this instruction maps to the same source file as the previous instruction.
+	// Source location that corresponds to this instruction, if any.
+	// Should always be set (if available) on the first instruction returned,
+	// but can be omitted afterwards if this instruction maps to the same source file as the previous instruction.
 	Location *Source `json:"location,omitempty"`
 
+	// A hint for how to present the instruction in the UI.
+	//
+	// A value of `invalid` may be used to indicate this instruction is 'filler' and cannot be reached by the program. For example, unreadable memory addresses may be presented is 'invalid.'
+	PresentationHint DisassembledInstructionPresentationHint `json:"presentationHint,omitempty"`
+
 	// Name of the symbol that corresponds with the location of this instruction, if any.
 	Symbol string `json:"symbol,omitempty"`
 }
 
-// DisconnectArguments Arguments for 'disconnect' request.
+// DisassembledInstructionPresentationHint A hint for how to present the instruction in the UI.
+//
+// A value of `invalid` may be used to indicate this instruction is 'filler' and cannot be reached by the program. For example, unreadable memory addresses may be presented is 'invalid.'
+type DisassembledInstructionPresentationHint string
+
+const (
+	DisassembledInstructionPresentationHintNormal  DisassembledInstructionPresentationHint = "normal"
+	DisassembledInstructionPresentationHintInvalid DisassembledInstructionPresentationHint = "invalid"
+)
+
+// valid reports whether e is one of the values of DisassembledInstructionPresentationHint.
+func (e DisassembledInstructionPresentationHint) valid() bool {
+	switch e {
+	case DisassembledInstructionPresentationHintNormal, DisassembledInstructionPresentationHintInvalid:
+		return true
+	}
+	return false
+}
+
+// DisconnectArguments Arguments for `disconnect` request.
 type DisconnectArguments struct {
-	// A value of true indicates that this 'disconnect' request is part of a restart sequence.
+	// A value of true indicates that this `disconnect` request is part of a restart sequence.
 	Restart bool `json:"restart,omitempty"`
 
+	// Indicates whether the debuggee should stay suspended when the debugger is disconnected.
+	// If unspecified, the debuggee should resume execution.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportSuspendDebuggee` is true.
+	SuspendDebuggee bool `json:"suspendDebuggee,omitempty"`
+
 	// Indicates whether the debuggee should be terminated when the debugger is disconnected.
 	// If unspecified, the debug adapter is free to do whatever it thinks is best.
-	// A client can only rely on this attribute being properly honored if a debug adapter returns true for the 'supportTerminateDebuggee' capability.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportTerminateDebuggee` is true.
 	TerminateDebuggee bool `json:"terminateDebuggee,omitempty"`
 }
 
 // DisconnectRequest Disconnect request; value of command field is 'disconnect'.
-// The 'disconnect' request is sent from the client to the debug adapter in order to stop debugging. It asks the debug adapter to disconnect from the debuggee and to terminate the debug adapter. If the debuggee has been started with the 'launch' request, the 'disconnect' request terminates the debuggee. If the 'attach' request was used to connect to the debuggee, 'disconnect' does not terminate the debuggee. This behavior can be controlled with the 'terminateDebuggee' argument (if supported by the debug adapter).
+// The `disconnect` request asks the debug adapter to disconnect from the debuggee (thus ending the debug session) and then to shut down itself (the debug adapter).
+// In addition, the debug adapter must terminate the debuggee if it was started with the `launch` request. If an `attach` request was used to connect to the debuggee, then the debug adapter must not terminate the debuggee.
+// This implicit behavior of when to terminate the debuggee can be overridden with the `terminateDebuggee` argument (which is only supported by a debug adapter if the corresponding capability `supportTerminateDebuggee` is true).
 type DisconnectRequest struct {
+	Request
+
 	// Object containing arguments for the command.
 	Arguments *DisconnectArguments `json:"arguments,omitempty"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *DisconnectRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// DisconnectResponse Response to `disconnect` request. This is just an acknowledgement, so no body field is required.
+type DisconnectResponse struct {
+	Response
 }
 
-// DisconnectResponse Response to 'disconnect' request. This is just an acknowledgement, so no body field is required.
-type DisconnectResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// ErrorMessage A structured message object. Used to return errors from requests.
+type ErrorMessage struct {
+	// A format string for the message. Embedded variables have the form `{name}`.
+	// If variable name starts with an underscore character, the variable does not contain user data (PII) and can be safely used for telemetry purposes.
+	Format string `json:"format"`
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Unique (within a debug adapter implementation) identifier for the message. The purpose of these error IDs is to help extension authors that have the requirement that every user visible error message needs a corresponding error number, so that users or customer support can find information about the specific error more easily.
+	Id int `json:"id"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// If true send to telemetry.
+	SendTelemetry bool `json:"sendTelemetry,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// If true show user.
+	ShowUser bool `json:"showUser,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// A url where additional information about this message can be found.
+	Url string `json:"url,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// A label that is presented to the user as the UI for opening the url.
+	UrlLabel string `json:"urlLabel,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// An object used as a dictionary for looking up the variables in the format string.
+	Variables map[string]string `json:"variables,omitempty"`
 }
 
-// ErrorResponse On error (whenever 'success' is false), the body can provide more details.
+// ErrorResponse On error (whenever `success` is false), the body can provide more details.
 type ErrorResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	Response
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ErrorResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ErrorResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ErrorResponseBody Contains request result if success is true and error details if success is false.
+type ErrorResponseBody struct {
+	// A structured error message.
+	Error *ErrorMessage `json:"error,omitempty"`
 }
 
-// EvaluateArguments Arguments for 'evaluate' request.
+// EvaluateArguments Arguments for `evaluate` request.
 type EvaluateArguments struct {
-	// The context in which the evaluate request is run.
+	// The contextual column where the expression should be evaluated. This may be provided if `line` is also provided.
+	//
+	// It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
+
+	// The context in which the evaluate request is used.
 	// Values:
-	// 'watch': evaluate is run in a watch.
-	// 'repl': evaluate is run from REPL console.
-	// 'hover': evaluate is run from a data hover.
+	// 'watch': evaluate is called from a watch view context.
+	// 'repl': evaluate is called from a REPL context.
+	// 'hover': evaluate is called to generate the debug hover contents.
+	// This value should only be used if the corresponding capability `supportsEvaluateForHovers` is true.
+	// 'clipboard': evaluate is called to generate clipboard contents.
+	// This value should only be used if the corresponding capability `supportsClipboardContext` is true.
+	// 'variables': evaluate is called from a variables view context.
 	// etc.
-	Context string `json:"context,omitempty"`
+	Context EvaluateArgumentsContext `json:"context,omitempty"`
 
 	// The expression to evaluate.
-	Expression string `json:"expression,omitempty"`
+	Expression string `json:"expression"`
 
-	// Specifies details on how to format the Evaluate result.
+	// Specifies details on how to format the result.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsValueFormattingOptions` is true.
 	Format *ValueFormat `json:"format,omitempty"`
 
 	// Evaluate the expression in the scope of this stack frame. If not specified, the expression is evaluated in the global scope.
-	FrameId float64 `json:"frameId,omitempty"`
+	FrameId int `json:"frameId,omitempty"`
+
+	// The contextual line where the expression should be evaluated. In the 'hover' context, this should be set to the start of the expression being hovered.
+	Line int `json:"line,omitempty"`
+
+	// The contextual source in which the `line` is found. This must be provided if `line` is provided.
+	Source *Source `json:"source,omitempty"`
 }
 
+// EvaluateArgumentsContext The context in which the evaluate request is used.
+type EvaluateArgumentsContext string
+
+const (
+	// EvaluateArgumentsContextWatch evaluate is called from a watch view context.
+	EvaluateArgumentsContextWatch EvaluateArgumentsContext = "watch"
+
+	// EvaluateArgumentsContextRepl evaluate is called from a REPL context.
+	EvaluateArgumentsContextRepl EvaluateArgumentsContext = "repl"
+
+	// EvaluateArgumentsContextHover evaluate is called to generate the debug hover contents.
+	// This value should only be used if the corresponding capability `supportsEvaluateForHovers` is true.
+	EvaluateArgumentsContextHover EvaluateArgumentsContext = "hover"
+
+	// EvaluateArgumentsContextClipboard evaluate is called to generate clipboard contents.
+	// This value should only be used if the corresponding capability `supportsClipboardContext` is true.
+	EvaluateArgumentsContextClipboard EvaluateArgumentsContext = "clipboard"
+
+	// EvaluateArgumentsContextVariables evaluate is called from a variables view context.
+	EvaluateArgumentsContextVariables EvaluateArgumentsContext = "variables"
+)
+
 // EvaluateRequest Evaluate request; value of command field is 'evaluate'.
-// Evaluates the given expression in the context of the top most stack frame.
+// Evaluates the given expression in the context of a stack frame.
 // The expression has access to any variables and arguments that are in scope.
 type EvaluateRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *EvaluateArguments `json:"arguments,omitempty"`
+	Arguments *EvaluateArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *EvaluateRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// EvaluateResponse Response to `evaluate` request.
+type EvaluateResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *EvaluateResponseBody `json:"body"`
 }
 
-// EvaluateResponse Response to 'evaluate' request.
-type EvaluateResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *EvaluateResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// EvaluateResponseBody Contains request result if success is true and error details if success is false.
+type EvaluateResponseBody struct {
+	// The number of indexed child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	IndexedVariables int `json:"indexedVariables,omitempty"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// A memory reference to a location appropriate for this result.
+	// For pointer type eval results, this is generally a reference to the memory address contained in the pointer.
+	// This attribute may be returned by a debug adapter if corresponding capability `supportsMemoryReferences` is true.
+	MemoryReference string `json:"memoryReference,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// The number of named child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	NamedVariables int `json:"namedVariables,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Properties of an evaluate result that can be used to determine how to render the result in the UI.
+	PresentationHint *VariablePresentationHint `json:"presentationHint,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The result of the evaluate request.
+	Result string `json:"result"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
+	// The type of the evaluate result.
+	// This attribute should only be returned by a debug adapter if the corresponding capability `supportsVariableType` is true.
 	Type string `json:"type,omitempty"`
+
+	// A reference that allows the client to request the location where the returned value is declared. For example, if a function pointer is returned, the adapter may be able to look up the function's location. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	ValueLocationReference int `json:"valueLocationReference,omitempty"`
+
+	// If `variablesReference` is > 0, the evaluate result is structured and its children can be retrieved by passing `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference"`
 }
 
 // Event A debug adapter initiated event.
 type Event struct {
+	ProtocolMessage
+
 	// Event-specific information.
-	Body interface{} `json:"body,omitempty"`
+	Body json.RawMessage `json:"body,omitempty"`
 
 	// Type of event.
-	Event string `json:"event,omitempty"`
+	Event string `json:"event"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ExceptionBreakMode This enumeration defines all possible conditions when a thrown exception should result in a break.
+// never: never breaks,
+// always: always breaks,
+// unhandled: breaks when exception unhandled,
+// userUnhandled: breaks if the exception is not handled by user code.
+type ExceptionBreakMode string
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+const (
+	ExceptionBreakModeNever         ExceptionBreakMode = "never"
+	ExceptionBreakModeAlways        ExceptionBreakMode = "always"
+	ExceptionBreakModeUnhandled     ExceptionBreakMode = "unhandled"
+	ExceptionBreakModeUserUnhandled ExceptionBreakMode = "userUnhandled"
+)
+
+// valid reports whether e is one of the values of ExceptionBreakMode.
+func (e ExceptionBreakMode) valid() bool {
+	switch e {
+	case ExceptionBreakModeNever, ExceptionBreakModeAlways, ExceptionBreakModeUnhandled, ExceptionBreakModeUserUnhandled:
+		return true
+	}
+	return false
 }
 
-// ExceptionBreakpointsFilter An ExceptionBreakpointsFilter is shown in the UI as an option for configuring how exceptions are dealt with.
+// ExceptionBreakpointsFilter An `ExceptionBreakpointsFilter` is shown in the UI as an filter option for configuring how exceptions are dealt with.
 type ExceptionBreakpointsFilter struct {
-	// Initial value of the filter. If not specified a value 'false' is assumed.
+	// A help text providing information about the condition. This string is shown as the placeholder text for a text box and can be translated.
+	ConditionDescription string `json:"conditionDescription,omitempty"`
+
+	// Initial value of the filter option. If not specified a value false is assumed.
 	Default bool `json:"default,omitempty"`
 
-	// The internal ID of the filter. This value is passed to the setExceptionBreakpoints request.
-	Filter string `json:"filter,omitempty"`
+	// A help text providing additional information about the exception filter. This string is typically shown as a hover and can be translated.
+	Description string `json:"description,omitempty"`
+
+	// The internal ID of the filter option. This value is passed to the `setExceptionBreakpoints` request.
+	Filter string `json:"filter"`
+
+	// The name of the filter option. This is shown in the UI.
+	Label string `json:"label"`
 
-	// The name of the filter. This will be shown in the UI.
-	Label string `json:"label,omitempty"`
+	// Controls whether a condition can be specified for this filter option. If false or missing, a condition can not be set.
+	SupportsCondition bool `json:"supportsCondition,omitempty"`
 }
 
 // ExceptionDetails Detailed information about an exception that has occurred.
 type ExceptionDetails struct {
-	// Optional expression that can be evaluated in the current scope to obtain the exception object.
+	// An expression that can be evaluated in the current scope to obtain the exception object.
 	EvaluateName string `json:"evaluateName,omitempty"`
 
 	// Fully-qualified type name of the exception object.
@@ -1000,74 +1151,79 @@ type ExceptionDetails struct {
 	TypeName string `json:"typeName,omitempty"`
 }
 
-// ExceptionInfoArguments Arguments for 'exceptionInfo' request.
+// ExceptionFilterOptions An `ExceptionFilterOptions` is used to specify an exception filter together with a condition for the `setExceptionBreakpoints` request.
+type ExceptionFilterOptions struct {
+	// An expression for conditional exceptions.
+	// The exception breaks into the debugger if the result of the condition is true.
+	Condition string `json:"condition,omitempty"`
+
+	// ID of an exception filter returned by the `exceptionBreakpointFilters` capability.
+	FilterId string `json:"filterId"`
+
+	// The mode of this exception breakpoint. If defined, this must be one of the `breakpointModes` the debug adapter advertised in its `Capabilities`.
+	Mode string `json:"mode,omitempty"`
+}
+
+// ExceptionInfoArguments Arguments for `exceptionInfo` request.
 type ExceptionInfoArguments struct {
 	// Thread for which exception information should be retrieved.
-	ThreadId float64 `json:"threadId,omitempty"`
+	ThreadId int `json:"threadId"`
 }
 
 // ExceptionInfoRequest ExceptionInfo request; value of command field is 'exceptionInfo'.
 // Retrieves the details of the exception that caused this event to be raised.
+// Clients should only call this request if the corresponding capability `supportsExceptionInfoRequest` is true.
 type ExceptionInfoRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ExceptionInfoArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ExceptionInfoArguments `json:"arguments"`
 }
 
-// ExceptionInfoResponse Response to 'exceptionInfo' request.
+// GetArguments implements RequestMessage.
+func (m *ExceptionInfoRequest) GetArguments() interface{} { return m.Arguments }
+
+// ExceptionInfoResponse Response to `exceptionInfo` request.
 type ExceptionInfoResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+	Response
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ExceptionInfoResponseBody `json:"body"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ExceptionInfoResponse) GetBody() interface{} { return m.Body }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// ExceptionInfoResponseBody Contains request result if success is true and error details if success is false.
+type ExceptionInfoResponseBody struct {
+	// Mode that caused the exception notification to be raised.
+	BreakMode ExceptionBreakMode `json:"breakMode"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Descriptive text for the exception.
+	Description string `json:"description,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// Detailed information about the exception.
+	Details *ExceptionDetails `json:"details,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// ID of the exception that was thrown.
+	ExceptionId string `json:"exceptionId"`
 }
 
-// ExceptionOptions An ExceptionOptions assigns configuration options to a set of exceptions.
+// ExceptionOptions An `ExceptionOptions` assigns configuration options to a set of exceptions.
 type ExceptionOptions struct {
 	// Condition when a thrown exception should result in a break.
-	BreakMode string `json:"breakMode,omitempty"`
+	BreakMode ExceptionBreakMode `json:"breakMode"`
 
-	// A path that selects a single or multiple exceptions in a tree. If 'path' is missing, the whole tree is selected. By convention the first segment of the path is a category that is used to group exceptions in the UI.
+	// A path that selects a single or multiple exceptions in a tree. If `path` is missing, the whole tree is selected.
+	// By convention the first segment of the path is a category that is used to group exceptions in the UI.
 	Path []*ExceptionPathSegment `json:"path,omitempty"`
 }
 
-// ExceptionPathSegment An ExceptionPathSegment represents a segment in a path that is used to match leafs or nodes in a tree of exceptions. If a segment consists of more than one name, it matches the names provided if 'negate' is false or missing or it matches anything except the names provided if 'negate' is true.
+// ExceptionPathSegment An `ExceptionPathSegment` represents a segment in a path that is used to match leafs or nodes in a tree of exceptions.
+// If a segment consists of more than one name, it matches the names provided if `negate` is false or missing, or it matches anything except the names provided if `negate` is true.
 type ExceptionPathSegment struct {
-	// Depending on the value of 'negate' the names that should match or not match.
-	Names []string `json:"names,omitempty"`
+	// Depending on the value of `negate` the names that should match or not match.
+	Names []string `json:"names"`
 
 	// If false or missing this segment matches the names provided, otherwise it matches anything except the names provided.
 	Negate bool `json:"negate,omitempty"`
@@ -1076,466 +1232,496 @@ type ExceptionPathSegment struct {
 // ExitedEvent Event message for 'exited' event type.
 // The event indicates that the debuggee has exited and returns its exit code.
 type ExitedEvent struct {
-	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Event
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+	// Event-specific information.
+	Body *ExitedEventBody `json:"body"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetBody implements EventMessage.
+func (m *ExitedEvent) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ExitedEventBody Event-specific information.
+type ExitedEventBody struct {
+	// The exit code returned from the debuggee.
+	ExitCode int `json:"exitCode"`
 }
 
-// FunctionBreakpoint Properties of a breakpoint passed to the setFunctionBreakpoints request.
+// FunctionBreakpoint Properties of a breakpoint passed to the `setFunctionBreakpoints` request.
 type FunctionBreakpoint struct {
-	// An optional expression for conditional breakpoints.
+	// An expression for conditional breakpoints.
+	// It is only honored by a debug adapter if the corresponding capability `supportsConditionalBreakpoints` is true.
 	Condition string `json:"condition,omitempty"`
 
-	// An optional expression that controls how many hits of the breakpoint are ignored. The backend is expected to interpret the expression as needed.
+	// An expression that controls how many hits of the breakpoint are ignored.
+	// The debug adapter is expected to interpret the expression as needed.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsHitConditionalBreakpoints` is true.
 	HitCondition string `json:"hitCondition,omitempty"`
 
 	// The name of the function.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 }
 
-// GotoArguments Arguments for 'goto' request.
+// GotoArguments Arguments for `goto` request.
 type GotoArguments struct {
 	// The location where the debuggee will continue to run.
-	TargetId float64 `json:"targetId,omitempty"`
+	TargetId int `json:"targetId"`
 
 	// Set the goto target for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	ThreadId int `json:"threadId"`
 }
 
 // GotoRequest Goto request; value of command field is 'goto'.
 // The request sets the location where the debuggee will continue to run.
-// This makes it possible to skip the execution of code or to executed code again.
+// This makes it possible to skip the execution of code or to execute code again.
 // The code between the current location and the goto target is not executed but skipped.
-// The debug adapter first sends the response and then a 'stopped' event with reason 'goto'.
+// The debug adapter first sends the response and then a `stopped` event with reason `goto`.
+// Clients should only call this request if the corresponding capability `supportsGotoTargetsRequest` is true (because only then goto targets exist that can be passed as arguments).
 type GotoRequest struct {
-	// Object containing arguments for the command.
-	Arguments *GotoArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *GotoArguments `json:"arguments"`
 }
 
-// GotoResponse Response to 'goto' request. This is just an acknowledgement, so no body field is required.
-type GotoResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *GotoRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// GotoResponse Response to `goto` request. This is just an acknowledgement, so no body field is required.
+type GotoResponse struct {
+	Response
 }
 
-// GotoTarget A GotoTarget describes a code location that can be used as a target in the 'goto' request.
-// The possible goto targets can be determined via the 'gotoTargets' request.
+// GotoTarget A `GotoTarget` describes a code location that can be used as a target in the `goto` request.
+// The possible goto targets can be determined via the `gotoTargets` request.
 type GotoTarget struct {
-	// An optional column of the goto target.
-	Column float64 `json:"column,omitempty"`
+	// The column of the goto target.
+	Column int `json:"column,omitempty"`
 
-	// An optional end column of the range covered by the goto target.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// The end column of the range covered by the goto target.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// An optional end line of the range covered by the goto target.
-	EndLine float64 `json:"endLine,omitempty"`
+	// The end line of the range covered by the goto target.
+	EndLine int `json:"endLine,omitempty"`
 
-	// Unique identifier for a goto target. This is used in the goto request.
-	Id float64 `json:"id,omitempty"`
+	// Unique identifier for a goto target. This is used in the `goto` request.
+	Id int `json:"id"`
 
-	// Optional memory reference for the instruction pointer value represented by this target.
+	// A memory reference for the instruction pointer value represented by this target.
 	InstructionPointerReference string `json:"instructionPointerReference,omitempty"`
 
 	// The name of the goto target (shown in the UI).
-	Label string `json:"label,omitempty"`
+	Label string `json:"label"`
 
 	// The line of the goto target.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line"`
 }
 
-// GotoTargetsArguments Arguments for 'gotoTargets' request.
+// GotoTargetsArguments Arguments for `gotoTargets` request.
 type GotoTargetsArguments struct {
-	// An optional column location for which the goto targets are determined.
-	Column float64 `json:"column,omitempty"`
+	// The position within `line` for which the goto targets are determined. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
 	// The line location for which the goto targets are determined.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line"`
 
 	// The source location for which the goto targets are determined.
-	Source *Source `json:"source,omitempty"`
+	Source *Source `json:"source"`
 }
 
 // GotoTargetsRequest GotoTargets request; value of command field is 'gotoTargets'.
 // This request retrieves the possible goto targets for the specified source location.
-// These targets can be used in the 'goto' request.
-// The GotoTargets request may only be called if the 'supportsGotoTargetsRequest' capability exists and is true.
+// These targets can be used in the `goto` request.
+// Clients should only call this request if the corresponding capability `supportsGotoTargetsRequest` is true.
 type GotoTargetsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *GotoTargetsArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *GotoTargetsArguments `json:"arguments"`
 }
 
-// GotoTargetsResponse Response to 'gotoTargets' request.
-type GotoTargetsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *GotoTargetsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// GotoTargetsResponse Response to `gotoTargets` request.
+type GotoTargetsResponse struct {
+	Response
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *GotoTargetsResponseBody `json:"body"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *GotoTargetsResponse) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GotoTargetsResponseBody Contains request result if success is true and error details if success is false.
+type GotoTargetsResponseBody struct {
+	// The possible goto targets of the specified location.
+	Targets []*GotoTarget `json:"targets"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// InitializeRequest Initialize request; value of command field is 'initialize'.
+// The `initialize` request is sent as the first request from the client to the debug adapter in order to configure it with client capabilities and to retrieve capabilities from the debug adapter.
+// Until the debug adapter has responded with an `initialize` response, the client must not send any additional requests or events to the debug adapter.
+// In addition the debug adapter is not allowed to send any requests or events to the client until it has responded with an `initialize` response.
+// The `initialize` request may only be sent once.
+type InitializeRequest struct {
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *InitializeRequestArguments `json:"arguments"`
 }
 
-// InitializeRequestArguments Arguments for 'initialize' request.
+// GetArguments implements RequestMessage.
+func (m *InitializeRequest) GetArguments() interface{} { return m.Arguments }
+
+// InitializeRequestArguments Arguments for `initialize` request.
 type InitializeRequestArguments struct {
 	// The ID of the debug adapter.
-	AdapterID string `json:"adapterID,omitempty"`
+	AdapterID string `json:"adapterID"`
 
-	// The ID of the (frontend) client using this adapter.
+	// The ID of the client using this adapter.
 	ClientID string `json:"clientID,omitempty"`
 
-	// The human readable name of the (frontend) client using this adapter.
+	// The human-readable name of the client using this adapter.
 	ClientName string `json:"clientName,omitempty"`
 
 	// If true all column numbers are 1-based (default).
-	ColumnsStartAt1 bool `json:"columnsStartAt1,omitempty"`
+	ColumnsStartAt1 *bool `json:"columnsStartAt1,omitempty"`
 
 	// If true all line numbers are 1-based (default).
-	LinesStartAt1 bool `json:"linesStartAt1,omitempty"`
+	LinesStartAt1 *bool `json:"linesStartAt1,omitempty"`
 
-	// The ISO-639 locale of the (frontend) client using this adapter, e.g. en-US or de-CH.
+	// The ISO-639 locale of the client using this adapter, e.g. en-US or de-CH.
 	Locale string `json:"locale,omitempty"`
 
-	// Determines in what format paths are specified. The default is 'path', which is the native format.
+	// Determines in what format paths are specified. The default is `path`, which is the native format.
 	// Values: 'path', 'uri', etc.
-	PathFormat string `json:"pathFormat,omitempty"`
+	PathFormat InitializeRequestArgumentsPathFormat `json:"pathFormat,omitempty"`
+
+	// The client will interpret ANSI escape sequences in the display of `OutputEvent.output` and `Variable.value` fields when `Capabilities.supportsANSIStyling` is also enabled.
+	SupportsANSIStyling bool `json:"supportsANSIStyling,omitempty"`
+
+	// Client supports the `argsCanBeInterpretedByShell` attribute on the `runInTerminal` request.
+	SupportsArgsCanBeInterpretedByShell bool `json:"supportsArgsCanBeInterpretedByShell,omitempty"`
+
+	// Client supports the `invalidated` event.
+	SupportsInvalidatedEvent bool `json:"supportsInvalidatedEvent,omitempty"`
+
+	// Client supports the `memory` event.
+	SupportsMemoryEvent bool `json:"supportsMemoryEvent,omitempty"`
 
 	// Client supports memory references.
 	SupportsMemoryReferences bool `json:"supportsMemoryReferences,omitempty"`
 
-	// Client supports the runInTerminal request.
+	// Client supports progress reporting.
+	SupportsProgressReporting bool `json:"supportsProgressReporting,omitempty"`
+
+	// Client supports the `runInTerminal` request.
 	SupportsRunInTerminalRequest bool `json:"supportsRunInTerminalRequest,omitempty"`
 
+	// Client supports the `startDebugging` request.
+	SupportsStartDebuggingRequest bool `json:"supportsStartDebuggingRequest,omitempty"`
+
 	// Client supports the paging of variables.
 	SupportsVariablePaging bool `json:"supportsVariablePaging,omitempty"`
 
-	// Client supports the optional type attribute for variables.
+	// Client supports the `type` attribute for variables.
 	SupportsVariableType bool `json:"supportsVariableType,omitempty"`
 }
 
-// InitializeRequest Initialize request; value of command field is 'initialize'.
-// The 'initialize' request is sent as the first request from the client to the debug adapter in order to configure it with client capabilities and to retrieve capabilities from the debug adapter.
-// Until the debug adapter has responded to with an 'initialize' response, the client must not send any additional requests or events to the debug adapter. In addition the debug adapter is not allowed to send any requests or events to the client until it has responded with an 'initialize' response.
-// The 'initialize' request may only be sent once.
-type InitializeRequest struct {
-	// Object containing arguments for the command.
-	Arguments *InitializeRequestArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// InitializeRequestArgumentsPathFormat Determines in what format paths are specified. The default is `path`, which is the native format.
+type InitializeRequestArgumentsPathFormat string
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+const (
+	InitializeRequestArgumentsPathFormatPath InitializeRequestArgumentsPathFormat = "path"
+	InitializeRequestArgumentsPathFormatUri  InitializeRequestArgumentsPathFormat = "uri"
+)
 
-// InitializeResponse Response to 'initialize' request.
+// InitializeResponse Response to `initialize` request.
 type InitializeResponse struct {
+	Response
+
 	// The capabilities of this debug adapter.
 	Body *Capabilities `json:"body,omitempty"`
+}
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *InitializeResponse) GetBody() interface{} { return m.Body }
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// InitializedEvent Event message for 'initialized' event type.
+// This event indicates that the debug adapter is ready to accept configuration requests (e.g. `setBreakpoints`, `setExceptionBreakpoints`).
+// A debug adapter is expected to send this event when it is ready to accept configuration requests (but not before the `initialize` request has finished).
+// The sequence of events/requests is as follows:
+// - adapters sends `initialized` event (after the `initialize` request has returned)
+// - client sends zero or more `setBreakpoints` requests
+// - client sends one `setFunctionBreakpoints` request (if corresponding capability `supportsFunctionBreakpoints` is true)
+// - client sends a `setExceptionBreakpoints` request if one or more `exceptionBreakpointFilters` have been defined (or if `supportsConfigurationDoneRequest` is not true)
+// - client sends other future configuration requests
+// - client sends one `configurationDone` request to indicate the end of the configuration.
+type InitializedEvent struct {
+	Event
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// InstructionBreakpoint Properties of a breakpoint passed to the `setInstructionBreakpoints` request
+type InstructionBreakpoint struct {
+	// An expression for conditional breakpoints.
+	// It is only honored by a debug adapter if the corresponding capability `supportsConditionalBreakpoints` is true.
+	Condition string `json:"condition,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// An expression that controls how many hits of the breakpoint are ignored.
+	// The debug adapter is expected to interpret the expression as needed.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsHitConditionalBreakpoints` is true.
+	HitCondition string `json:"hitCondition,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The instruction reference of the breakpoint.
+	// This should be a memory or instruction pointer reference from an `EvaluateResponse`, `Variable`, `StackFrame`, `GotoTarget`, or `Breakpoint`.
+	InstructionReference string `json:"instructionReference"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The mode of this breakpoint. If defined, this must be one of the `breakpointModes` the debug adapter advertised in its `Capabilities`.
+	Mode string `json:"mode,omitempty"`
+
+	// The offset from the instruction reference in bytes.
+	// This can be negative.
+	Offset int `json:"offset,omitempty"`
 }
 
-// InitializedEvent Event message for 'initialized' event type.
-// This event indicates that the debug adapter is ready to accept configuration requests (e.g. SetBreakpointsRequest, SetExceptionBreakpointsRequest).
-// A debug adapter is expected to send this event when it is ready to accept configuration requests (but not before the 'initialize' request has finished).
-// The sequence of events/requests is as follows:
-// - adapters sends 'initialized' event (after the 'initialize' request has returned)
-// - frontend sends zero or more 'setBreakpoints' requests
-// - frontend sends one 'setFunctionBreakpoints' request
-// - frontend sends a 'setExceptionBreakpoints' request if one or more 'exceptionBreakpointFilters' have been defined (or if 'supportsConfigurationDoneRequest' is not defined or false)
-// - frontend sends other future configuration requests
-// - frontend sends one 'configurationDone' request to indicate the end of the configuration.
-type InitializedEvent struct {
+// InvalidatedAreas Logical areas that can be invalidated by the `invalidated` event.
+type InvalidatedAreas string
+
+const (
+	// InvalidatedAreasAll All previously fetched data has become invalid and needs to be refetched.
+	InvalidatedAreasAll InvalidatedAreas = "all"
+
+	// InvalidatedAreasStacks Previously fetched stack related data has become invalid and needs to be refetched.
+	InvalidatedAreasStacks InvalidatedAreas = "stacks"
+
+	// InvalidatedAreasThreads Previously fetched thread related data has become invalid and needs to be refetched.
+	InvalidatedAreasThreads InvalidatedAreas = "threads"
+
+	// InvalidatedAreasVariables Previously fetched variable data has become invalid and needs to be refetched.
+	InvalidatedAreasVariables InvalidatedAreas = "variables"
+)
+
+// InvalidatedEvent Event message for 'invalidated' event type.
+// This event signals that some state in the debug adapter has changed and requires that the client needs to re-render the data snapshot previously requested.
+// Debug adapters do not have to emit this event for runtime changes like stopped or thread events because in that case the client refetches the new state anyway. But the event can be used for example to refresh the UI after rendering formatting has changed in the debug adapter.
+// This event should only be sent if the corresponding capability `supportsInvalidatedEvent` is true.
+type InvalidatedEvent struct {
+	Event
+
 	// Event-specific information.
-	Body interface{} `json:"body,omitempty"`
+	Body *InvalidatedEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *InvalidatedEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// InvalidatedEventBody Event-specific information.
+type InvalidatedEventBody struct {
+	// Set of logical areas that got invalidated. This property has a hint characteristic: a client can only be expected to make a 'best effort' in honoring the areas but there are no guarantees. If this property is missing, empty, or if values are not understood, the client should assume a single value `all`.
+	Areas []InvalidatedAreas `json:"areas,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// If specified, the client only needs to refetch data related to this stack frame (and the `threadId` is ignored).
+	StackFrameId int `json:"stackFrameId,omitempty"`
+
+	// If specified, the client only needs to refetch data related to this thread.
+	ThreadId int `json:"threadId,omitempty"`
 }
 
 // LaunchRequest Launch request; value of command field is 'launch'.
-// The launch request is sent from the client to the debug adapter to start the debuggee with or without debugging (if 'noDebug' is true). Since launching is debugger/runtime specific, the arguments for this request are not part of this specification.
+// This launch request is sent from the client to the debug adapter to start the debuggee with or without debugging (if `noDebug` is true).
+// Since launching is debugger/runtime specific, the arguments for this request are not part of this specification.
 type LaunchRequest struct {
-	// Object containing arguments for the command.
-	Arguments *LaunchRequestArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *LaunchRequestArguments `json:"arguments"`
 }
 
-// LaunchRequestArguments Arguments for 'launch' request. Additional attributes are implementation specific.
+// GetArguments implements RequestMessage.
+func (m *LaunchRequest) GetArguments() interface{} { return m.Arguments }
+
+// LaunchRequestArguments Arguments for `launch` request. Additional attributes are implementation specific.
 type LaunchRequestArguments struct {
-	// If noDebug is true the launch request should launch the program without enabling debugging.
+	// If true, the launch request should launch the program without enabling debugging.
 	NoDebug bool `json:"noDebug,omitempty"`
 
-	// Optional data from the previous, restarted session.
-	// The data is sent as the 'restart' attribute of the 'terminated' event.
+	// Arbitrary data from the previous, restarted session.
+	// The data is sent as the `restart` attribute of the `terminated` event.
 	// The client should leave the data intact.
 	Restart interface{} `json:"__restart,omitempty"`
+
+	// Raw is the JSON encoding of the arguments including the implementation specific attributes.
+	Raw json.RawMessage `json:"-"`
 }
 
-// LaunchResponse Response to 'launch' request. This is just an acknowledgement, so no body field is required.
+// LaunchResponse Response to `launch` request. This is just an acknowledgement, so no body field is required.
 type LaunchResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	Response
 }
 
 // LoadedSourceEvent Event message for 'loadedSource' event type.
 // The event indicates that some source has been added, changed, or removed from the set of all loaded sources.
 type LoadedSourceEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *LoadedSourceEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *LoadedSourceEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// LoadedSourceEventBody Event-specific information.
+type LoadedSourceEventBody struct {
+	// The reason for the event.
+	Reason LoadedSourceEventReason `json:"reason"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The new, changed, or removed source.
+	Source *Source `json:"source"`
 }
 
-// LoadedSourcesArguments Arguments for 'loadedSources' request.
-type LoadedSourcesArguments struct {
+// LoadedSourceEventReason The reason for the event.
+type LoadedSourceEventReason string
+
+const (
+	LoadedSourceEventReasonNew     LoadedSourceEventReason = "new"
+	LoadedSourceEventReasonChanged LoadedSourceEventReason = "changed"
+	LoadedSourceEventReasonRemoved LoadedSourceEventReason = "removed"
+)
+
+// valid reports whether e is one of the values of LoadedSourceEventReason.
+func (e LoadedSourceEventReason) valid() bool {
+	switch e {
+	case LoadedSourceEventReasonNew, LoadedSourceEventReasonChanged, LoadedSourceEventReasonRemoved:
+		return true
+	}
+	return false
 }
 
+// LoadedSourcesArguments Arguments for `loadedSources` request.
+type LoadedSourcesArguments struct{}
+
 // LoadedSourcesRequest LoadedSources request; value of command field is 'loadedSources'.
 // Retrieves the set of all sources currently loaded by the debugged process.
+// Clients should only call this request if the corresponding capability `supportsLoadedSourcesRequest` is true.
 type LoadedSourcesRequest struct {
+	Request
+
 	// Object containing arguments for the command.
 	Arguments *LoadedSourcesArguments `json:"arguments,omitempty"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *LoadedSourcesRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// LoadedSourcesResponse Response to `loadedSources` request.
+type LoadedSourcesResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *LoadedSourcesResponseBody `json:"body"`
 }
 
-// LoadedSourcesResponse Response to 'loadedSources' request.
-type LoadedSourcesResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *LoadedSourcesResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// LoadedSourcesResponseBody Contains request result if success is true and error details if success is false.
+type LoadedSourcesResponseBody struct {
+	// Set of loaded sources.
+	Sources []*Source `json:"sources"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// LocationsArguments Arguments for `locations` request.
+type LocationsArguments struct {
+	// Location reference to resolve.
+	LocationReference int `json:"locationReference"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// LocationsRequest Locations request; value of command field is 'locations'.
+// Looks up information about a location reference previously returned by the debug adapter.
+type LocationsRequest struct {
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *LocationsArguments `json:"arguments"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *LocationsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// LocationsResponse Response to `locations` request.
+type LocationsResponse struct {
+	Response
+
+	// Contains request result if success is true and error details if success is false.
+	Body *LocationsResponseBody `json:"body,omitempty"`
 }
 
-// Message A structured message object. Used to return errors from requests.
-type Message struct {
-	// A format string for the message. Embedded variables have the form '{name}'.
-	// If variable name starts with an underscore character, the variable does not contain user data (PII) and can be safely used for telemetry purposes.
-	Format string `json:"format,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *LocationsResponse) GetBody() interface{} { return m.Body }
 
-	// Unique identifier for the message.
-	Id float64 `json:"id,omitempty"`
+// LocationsResponseBody Contains request result if success is true and error details if success is false.
+type LocationsResponseBody struct {
+	// Position of the location within the `line`. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based. If no column is given, the first position in the start line is assumed.
+	Column int `json:"column,omitempty"`
 
-	// If true send to telemetry.
-	SendTelemetry bool `json:"sendTelemetry,omitempty"`
+	// End position of the location within `endLine`, present if the location refers to a range. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// If true show user.
-	ShowUser bool `json:"showUser,omitempty"`
+	// End line of the location, present if the location refers to a range.  The client capability `linesStartAt1` determines whether it is 0- or 1-based.
+	EndLine int `json:"endLine,omitempty"`
 
-	// An optional url where additional information about this message can be found.
-	Url string `json:"url,omitempty"`
+	// The line number of the location. The client capability `linesStartAt1` determines whether it is 0- or 1-based.
+	Line int `json:"line"`
 
-	// An optional label that is presented to the user as the UI for opening the url.
-	UrlLabel string `json:"urlLabel,omitempty"`
+	// The source containing the location; either `source.path` or `source.sourceReference` must be specified.
+	Source *Source `json:"source"`
+}
 
-	// An object used as a dictionary for looking up the variables in the format string.
-	Variables map[string]string `json:"variables,omitempty"`
+// MemoryEvent Event message for 'memory' event type.
+// This event indicates that some memory range has been updated. It should only be sent if the corresponding capability `supportsMemoryEvent` is true.
+// Clients typically react to the event by re-issuing a `readMemory` request if they show the memory identified by the `memoryReference` and if the updated memory range overlaps the displayed range. Clients should not make assumptions how individual memory references relate to each other, so they should not assume that they are part of a single continuous address range and might overlap.
+// Debug adapters can use this event to indicate that the contents of a memory range has changed due to some other request like `setVariable` or `setExpression`. Debug adapters are not expected to emit this event for each and every memory change of a running program, because that information is typically not available from debuggers and it would flood clients with too many events.
+type MemoryEvent struct {
+	Event
+
+	// Event-specific information.
+	Body *MemoryEventBody `json:"body"`
+}
+
+// GetBody implements EventMessage.
+func (m *MemoryEvent) GetBody() interface{} { return m.Body }
+
+// MemoryEventBody Event-specific information.
+type MemoryEventBody struct {
+	// Number of bytes updated.
+	Count int `json:"count"`
+
+	// Memory reference of a memory range that has been updated.
+	MemoryReference string `json:"memoryReference"`
+
+	// Starting offset in bytes where memory has been updated. Can be negative.
+	Offset int `json:"offset"`
 }
 
 // Module A Module object represents a row in the modules view.
-// Two attributes are mandatory: an id identifies a module in the modules view and is used in a ModuleEvent for identifying a module for adding, updating or deleting.
-// The name is used to minimally render the module in the UI.
+// The `id` attribute identifies a module in the modules view and is used in a `module` event for identifying a module for adding, updating or deleting.
+// The `name` attribute is used to minimally render the module in the UI.
 //
-// Additional attributes can be added to the module. They will show up in the module View if they have a corresponding ColumnDescriptor.
+// Additional attributes can be added to the module. They show up in the module view if they have a corresponding `ColumnDescriptor`.
 //
-// To avoid an unnecessary proliferation of additional attributes with similar semantics but different names
-// we recommend to re-use attributes from the 'recommended' list below first, and only introduce new attributes if nothing appropriate could be found.
+// To avoid an unnecessary proliferation of additional attributes with similar semantics but different names, we recommend to re-use attributes from the 'recommended' list below first, and only introduce new attributes if nothing appropriate could be found.
 type Module struct {
 	// Address range covered by this module.
 	AddressRange string `json:"addressRange,omitempty"`
 
-	// Module created or modified.
+	// Module created or modified, encoded as a RFC 3339 timestamp.
 	DateTimeStamp string `json:"dateTimeStamp,omitempty"`
 
 	// Unique identifier for the module.
-	Id interface{} `json:"id,omitempty"`
+	Id interface{} `json:"id"`
 
 	// True if the module is optimized.
 	IsOptimized bool `json:"isOptimized,omitempty"`
@@ -1544,712 +1730,778 @@ type Module struct {
 	IsUserCode bool `json:"isUserCode,omitempty"`
 
 	// A name of the module.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 
-	// optional but recommended attributes.
-	// always try to use these first before introducing additional attributes.
-	//
 	// Logical full path to the module. The exact definition is implementation defined, but usually this would be a full path to the on-disk file for the module.
 	Path string `json:"path,omitempty"`
 
 	// Logical full path to the symbol file. The exact definition is implementation defined.
 	SymbolFilePath string `json:"symbolFilePath,omitempty"`
 
-	// User understandable description of if symbols were found for the module (ex: 'Symbols Loaded', 'Symbols not found', etc.
+	// User-understandable description of if symbols were found for the module (ex: 'Symbols Loaded', 'Symbols not found', etc.)
 	SymbolStatus string `json:"symbolStatus,omitempty"`
 
 	// Version of Module.
 	Version string `json:"version,omitempty"`
+
+	// Extra holds the additional attributes which are not defined by the protocol.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ModuleEvent Event message for 'module' event type.
 // The event indicates that some information about a module has changed.
 type ModuleEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ModuleEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *ModuleEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ModuleEventBody Event-specific information.
+type ModuleEventBody struct {
+	// The new, changed, or removed module. In case of `removed` only the module id is used.
+	Module *Module `json:"module"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The reason for the event.
+	Reason ModuleEventReason `json:"reason"`
+}
+
+// ModuleEventReason The reason for the event.
+type ModuleEventReason string
+
+const (
+	ModuleEventReasonNew     ModuleEventReason = "new"
+	ModuleEventReasonChanged ModuleEventReason = "changed"
+	ModuleEventReasonRemoved ModuleEventReason = "removed"
+)
+
+// valid reports whether e is one of the values of ModuleEventReason.
+func (e ModuleEventReason) valid() bool {
+	switch e {
+	case ModuleEventReasonNew, ModuleEventReasonChanged, ModuleEventReasonRemoved:
+		return true
+	}
+	return false
 }
 
-// ModulesArguments Arguments for 'modules' request.
+// ModulesArguments Arguments for `modules` request.
 type ModulesArguments struct {
-	// The number of modules to return. If moduleCount is not specified or 0, all modules are returned.
-	ModuleCount float64 `json:"moduleCount,omitempty"`
+	// The number of modules to return. If `moduleCount` is not specified or 0, all modules are returned.
+	ModuleCount int `json:"moduleCount,omitempty"`
 
 	// The index of the first module to return; if omitted modules start at 0.
-	StartModule float64 `json:"startModule,omitempty"`
+	StartModule int `json:"startModule,omitempty"`
 }
 
 // ModulesRequest Modules request; value of command field is 'modules'.
-// Modules can be retrieved from the debug adapter with the ModulesRequest which can either return all modules or a range of modules to support paging.
+// Modules can be retrieved from the debug adapter with this request which can either return all modules or a range of modules to support paging.
+// Clients should only call this request if the corresponding capability `supportsModulesRequest` is true.
 type ModulesRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ModulesArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ModulesArguments `json:"arguments"`
 }
 
-// ModulesResponse Response to 'modules' request.
-type ModulesResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ModulesRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// ModulesResponse Response to `modules` request.
+type ModulesResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ModulesResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ModulesResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+// ModulesResponseBody Contains request result if success is true and error details if success is false.
+type ModulesResponseBody struct {
+	// All modules or range of modules.
+	Modules []*Module `json:"modules"`
 
-// ModulesViewDescriptor The ModulesViewDescriptor is the container for all declarative configuration options of a ModuleView.
-// For now it only specifies the columns to be shown in the modules view.
-type ModulesViewDescriptor struct {
-	Columns []*ColumnDescriptor `json:"columns,omitempty"`
+	// The total number of modules available.
+	TotalModules int `json:"totalModules,omitempty"`
 }
 
-// NextArguments Arguments for 'next' request.
+// NextArguments Arguments for `next` request.
 type NextArguments struct {
-	// Execute 'next' for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// Stepping granularity. If no granularity is specified, a granularity of `statement` is assumed.
+	Granularity SteppingGranularity `json:"granularity,omitempty"`
+
+	// If this flag is true, all other suspended threads are not resumed.
+	SingleThread bool `json:"singleThread,omitempty"`
+
+	// Specifies the thread for which to resume execution for one step (of the given granularity).
+	ThreadId int `json:"threadId"`
 }
 
 // NextRequest Next request; value of command field is 'next'.
-// The request starts the debuggee to run again for one step.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'step') after the step has completed.
+// The request executes one step (in the given granularity) for the specified thread and allows all other threads to run freely by resuming them.
+// If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true prevents other suspended threads from resuming.
+// The debug adapter first sends the response and then a `stopped` event (with reason `step`) after the step has completed.
 type NextRequest struct {
-	// Object containing arguments for the command.
-	Arguments *NextArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *NextArguments `json:"arguments"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *NextRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// NextResponse Response to `next` request. This is just an acknowledgement, so no body field is required.
+type NextResponse struct {
+	Response
 }
 
-// NextResponse Response to 'next' request. This is just an acknowledgement, so no body field is required.
-type NextResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// OutputEvent Event message for 'output' event type.
+// The event indicates that the target has produced some output.
+type OutputEvent struct {
+	Event
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Event-specific information.
+	Body *OutputEventBody `json:"body"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
+// GetBody implements EventMessage.
+func (m *OutputEvent) GetBody() interface{} { return m.Body }
+
+// OutputEventBody Event-specific information.
+type OutputEventBody struct {
+	// The output category. If not specified or if the category is not understood by the client, `console` is assumed.
 	// Values:
-	// 'cancelled': request was cancelled.
+	// 'console': Show the output in the client's default message UI, e.g. a 'debug console'. This category should only be used for informational output from the debugger (as opposed to the debuggee).
+	// 'important': A hint for the client to show the output in the client's UI for important and highly visible information, e.g. as a popup notification. This category should only be used for important messages from the debugger (as opposed to the debuggee). Since this category value is a hint, clients might ignore the hint and assume the `console` category.
+	// 'stdout': Show the output as normal program output from the debuggee.
+	// 'stderr': Show the output as error program output from the debuggee.
+	// 'telemetry': Send the output to telemetry instead of showing it to the user.
 	// etc.
-	Message string `json:"message,omitempty"`
+	Category OutputEventCategory `json:"category,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// The position in `line` where the output was produced. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Additional data to report. For the `telemetry` category the data is sent to telemetry, for the other categories the data is shown in JSON format.
+	Data interface{} `json:"data,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// Support for keeping an output log organized by grouping related messages.
+	Group OutputEventGroup `json:"group,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The source location's line where the output was produced.
+	Line int `json:"line,omitempty"`
+
+	// A reference that allows the client to request the location where the new value is declared. For example, if the logged value is function pointer, the adapter may be able to look up the function's location. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	LocationReference int `json:"locationReference,omitempty"`
+
+	// The output to report.
+	//
+	// ANSI escape sequences may be used to influence text color and styling if `supportsANSIStyling` is present in both the adapter's `Capabilities` and the client's `InitializeRequestArguments`. A client may strip any unrecognized ANSI sequences.
+	//
+	// If the `supportsANSIStyling` capabilities are not both true, then the client should display the output literally.
+	Output string `json:"output"`
+
+	// The source location where the output was produced.
+	Source *Source `json:"source,omitempty"`
+
+	// If an attribute `variablesReference` exists and its value is > 0, the output contains objects which can be retrieved by passing `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
-// OutputEvent Event message for 'output' event type.
-// The event indicates that the target has produced some output.
-type OutputEvent struct {
-	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+// OutputEventCategory The output category. If not specified or if the category is not understood by the client, `console` is assumed.
+type OutputEventCategory string
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+const (
+	// OutputEventCategoryConsole Show the output in the client's default message UI, e.g. a 'debug console'. This category should only be used for informational output from the debugger (as opposed to the debuggee).
+	OutputEventCategoryConsole OutputEventCategory = "console"
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// OutputEventCategoryImportant A hint for the client to show the output in the client's UI for important and highly visible information, e.g. as a popup notification. This category should only be used for important messages from the debugger (as opposed to the debuggee). Since this category value is a hint, clients might ignore the hint and assume the `console` category.
+	OutputEventCategoryImportant OutputEventCategory = "important"
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// OutputEventCategoryStdout Show the output as normal program output from the debuggee.
+	OutputEventCategoryStdout OutputEventCategory = "stdout"
+
+	// OutputEventCategoryStderr Show the output as error program output from the debuggee.
+	OutputEventCategoryStderr OutputEventCategory = "stderr"
+
+	// OutputEventCategoryTelemetry Send the output to telemetry instead of showing it to the user.
+	OutputEventCategoryTelemetry OutputEventCategory = "telemetry"
+)
+
+// OutputEventGroup Support for keeping an output log organized by grouping related messages.
+type OutputEventGroup string
+
+const (
+	// OutputEventGroupStart Start a new group in expanded mode. Subsequent output events are members of the group and should be shown indented.
+	// The `output` attribute becomes the name of the group and is not indented.
+	OutputEventGroupStart OutputEventGroup = "start"
+
+	// OutputEventGroupStartCollapsed Start a new group in collapsed mode. Subsequent output events are members of the group and should be shown indented (as soon as the group is expanded).
+	// The `output` attribute becomes the name of the group and is not indented.
+	OutputEventGroupStartCollapsed OutputEventGroup = "startCollapsed"
+
+	// OutputEventGroupEnd End the current group and decrease the indentation of subsequent output events.
+	// A non-empty `output` attribute is shown as the unindented end of the group.
+	OutputEventGroupEnd OutputEventGroup = "end"
+)
+
+// valid reports whether e is one of the values of OutputEventGroup.
+func (e OutputEventGroup) valid() bool {
+	switch e {
+	case OutputEventGroupStart, OutputEventGroupStartCollapsed, OutputEventGroupEnd:
+		return true
+	}
+	return false
 }
 
-// PauseArguments Arguments for 'pause' request.
+// PauseArguments Arguments for `pause` request.
 type PauseArguments struct {
 	// Pause execution for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	ThreadId int `json:"threadId"`
 }
 
 // PauseRequest Pause request; value of command field is 'pause'.
 // The request suspends the debuggee.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'pause') after the thread has been paused successfully.
+// The debug adapter first sends the response and then a `stopped` event (with reason `pause`) after the thread has been paused successfully.
 type PauseRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *PauseArguments `json:"arguments,omitempty"`
+	Arguments *PauseArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *PauseRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// PauseResponse Response to `pause` request. This is just an acknowledgement, so no body field is required.
+type PauseResponse struct {
+	Response
+}
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ProcessEvent Event message for 'process' event type.
+// The event indicates that the debugger has begun debugging a new process. Either one that it has launched, or one that it has attached to.
+type ProcessEvent struct {
+	Event
+
+	// Event-specific information.
+	Body *ProcessEventBody `json:"body"`
 }
 
-// PauseResponse Response to 'pause' request. This is just an acknowledgement, so no body field is required.
-type PauseResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// GetBody implements EventMessage.
+func (m *ProcessEvent) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// ProcessEventBody Event-specific information.
+type ProcessEventBody struct {
+	// If true, the process is running on the same computer as the debug adapter.
+	IsLocalProcess bool `json:"isLocalProcess,omitempty"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
+	// The logical name of the process. This is usually the full path to process's executable file. Example: /home/example/myproj/program.js.
+	Name string `json:"name"`
+
+	// The size of a pointer or address for this process, in bits. This value may be used by clients when formatting addresses for display.
+	PointerSize int `json:"pointerSize,omitempty"`
+
+	// Describes how the debug engine started debugging this process.
+	StartMethod ProcessEventStartMethod `json:"startMethod,omitempty"`
+
+	// The process ID of the debugged process, as assigned by the operating system. This property should be omitted for logical processes that do not map to operating system processes on the machine.
+	SystemProcessId int `json:"systemProcessId,omitempty"`
+}
+
+// ProcessEventStartMethod Describes how the debug engine started debugging this process.
+type ProcessEventStartMethod string
+
+const (
+	// ProcessEventStartMethodLaunch Process was launched under the debugger.
+	ProcessEventStartMethodLaunch ProcessEventStartMethod = "launch"
+
+	// ProcessEventStartMethodAttach Debugger attached to an existing process.
+	ProcessEventStartMethodAttach ProcessEventStartMethod = "attach"
+
+	// ProcessEventStartMethodAttachForSuspendedLaunch A project launcher component has launched a new process in a suspended state and then asked the debugger to attach.
+	ProcessEventStartMethodAttachForSuspendedLaunch ProcessEventStartMethod = "attachForSuspendedLaunch"
+)
+
+// valid reports whether e is one of the values of ProcessEventStartMethod.
+func (e ProcessEventStartMethod) valid() bool {
+	switch e {
+	case ProcessEventStartMethodLaunch, ProcessEventStartMethodAttach, ProcessEventStartMethodAttachForSuspendedLaunch:
+		return true
+	}
+	return false
+}
+
+// ProgressEndEvent Event message for 'progressEnd' event type.
+// The event signals the end of the progress reporting with a final message.
+// This event should only be sent if the corresponding capability `supportsProgressReporting` is true.
+type ProgressEndEvent struct {
+	Event
+
+	// Event-specific information.
+	Body *ProgressEndEventBody `json:"body"`
+}
+
+// GetBody implements EventMessage.
+func (m *ProgressEndEvent) GetBody() interface{} { return m.Body }
+
+// ProgressEndEventBody Event-specific information.
+type ProgressEndEventBody struct {
+	// More detailed progress message. If omitted, the previous message (if any) is used.
 	Message string `json:"message,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// The ID that was introduced in the initial `ProgressStartEvent`.
+	ProgressId string `json:"progressId"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ProgressStartEvent Event message for 'progressStart' event type.
+// The event signals that a long running operation is about to start and provides additional information for the client to set up a corresponding progress and cancellation UI.
+// The client is free to delay the showing of the UI in order to reduce flicker.
+// This event should only be sent if the corresponding capability `supportsProgressReporting` is true.
+type ProgressStartEvent struct {
+	Event
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// Event-specific information.
+	Body *ProgressStartEventBody `json:"body"`
+}
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// GetBody implements EventMessage.
+func (m *ProgressStartEvent) GetBody() interface{} { return m.Body }
+
+// ProgressStartEventBody Event-specific information.
+type ProgressStartEventBody struct {
+	// If true, the request that reports progress may be cancelled with a `cancel` request.
+	// So this property basically controls whether the client should use UX that supports cancellation.
+	// Clients that don't support cancellation are allowed to ignore the setting.
+	Cancellable bool `json:"cancellable,omitempty"`
+
+	// More detailed progress message.
+	Message string `json:"message,omitempty"`
+
+	// Progress percentage to display (value range: 0 to 100). If omitted no percentage is shown.
+	Percentage float64 `json:"percentage,omitempty"`
+
+	// An ID that can be used in subsequent `progressUpdate` and `progressEnd` events to make them refer to the same progress reporting.
+	// IDs must be unique within a debug session.
+	ProgressId string `json:"progressId"`
+
+	// The request ID that this progress report is related to. If specified a debug adapter is expected to emit progress events for the long running request until the request has been either completed or cancelled.
+	// If the request ID is omitted, the progress report is assumed to be related to some general activity of the debug adapter.
+	RequestId int `json:"requestId,omitempty"`
+
+	// Short title of the progress reporting. Shown in the UI to describe the long running operation.
+	Title string `json:"title"`
 }
 
-// ProcessEvent Event message for 'process' event type.
-// The event indicates that the debugger has begun debugging a new process. Either one that it has launched, or one that it has attached to.
-type ProcessEvent struct {
+// ProgressUpdateEvent Event message for 'progressUpdate' event type.
+// The event signals that the progress reporting needs to be updated with a new message and/or percentage.
+// The client does not have to update the UI immediately, but the clients needs to keep track of the message and/or percentage values.
+// This event should only be sent if the corresponding capability `supportsProgressReporting` is true.
+type ProgressUpdateEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ProgressUpdateEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *ProgressUpdateEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ProgressUpdateEventBody Event-specific information.
+type ProgressUpdateEventBody struct {
+	// More detailed progress message. If omitted, the previous message (if any) is used.
+	Message string `json:"message,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Progress percentage to display (value range: 0 to 100). If omitted no percentage is shown.
+	Percentage float64 `json:"percentage,omitempty"`
+
+	// The ID that was introduced in the initial `progressStart` event.
+	ProgressId string `json:"progressId"`
 }
 
 // ProtocolMessage Base class of requests, responses, and events.
 type ProtocolMessage struct {
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Sequence number of the message (also known as message ID). The `seq` for the first message sent by a client or debug adapter is 1, and for each subsequent message is 1 greater than the previous message sent by that actor. `seq` can be used to order requests, responses, and events, and to associate requests with their corresponding responses. For protocol messages of type `request` the sequence number can be used to cancel the request.
+	Seq int `json:"seq"`
 
 	// Message type.
 	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	Type ProtocolMessageType `json:"type"`
 }
 
-// ReadMemoryArguments Arguments for 'readMemory' request.
+// ProtocolMessageType Message type.
+type ProtocolMessageType string
+
+const (
+	ProtocolMessageTypeRequest  ProtocolMessageType = "request"
+	ProtocolMessageTypeResponse ProtocolMessageType = "response"
+	ProtocolMessageTypeEvent    ProtocolMessageType = "event"
+)
+
+// ReadMemoryArguments Arguments for `readMemory` request.
 type ReadMemoryArguments struct {
 	// Number of bytes to read at the specified location and offset.
-	Count float64 `json:"count,omitempty"`
+	Count int `json:"count"`
 
 	// Memory reference to the base location from which data should be read.
-	MemoryReference string `json:"memoryReference,omitempty"`
+	MemoryReference string `json:"memoryReference"`
 
-	// Optional offset (in bytes) to be applied to the reference location before reading data. Can be negative.
-	Offset float64 `json:"offset,omitempty"`
+	// Offset (in bytes) to be applied to the reference location before reading data. Can be negative.
+	Offset int `json:"offset,omitempty"`
 }
 
 // ReadMemoryRequest ReadMemory request; value of command field is 'readMemory'.
 // Reads bytes from memory at the provided location.
+// Clients should only call this request if the corresponding capability `supportsReadMemoryRequest` is true.
 type ReadMemoryRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ReadMemoryArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ReadMemoryArguments `json:"arguments"`
 }
 
-// ReadMemoryResponse Response to 'readMemory' request.
-type ReadMemoryResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ReadMemoryRequest) GetArguments() interface{} { return m.Arguments }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// ReadMemoryResponse Response to `readMemory` request.
+type ReadMemoryResponse struct {
+	Response
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ReadMemoryResponseBody `json:"body,omitempty"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ReadMemoryResponse) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ReadMemoryResponseBody Contains request result if success is true and error details if success is false.
+type ReadMemoryResponseBody struct {
+	// The address of the first byte of data returned.
+	// Treated as a hex value if prefixed with `0x`, or as a decimal value otherwise.
+	Address string `json:"address"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The bytes read from memory, encoded using base64. If the decoded length of `data` is less than the requested `count` in the original `readMemory` request, and `unreadableBytes` is zero or omitted, then the client should assume it's reached the end of readable memory.
+	Data string `json:"data,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The number of unreadable bytes encountered after the last successfully read byte.
+	// This can be used to determine the number of bytes that should be skipped before a subsequent `readMemory` request succeeds.
+	UnreadableBytes int `json:"unreadableBytes,omitempty"`
 }
 
 // Request A client or debug adapter initiated request.
 type Request struct {
+	ProtocolMessage
+
 	// Object containing arguments for the command.
-	Arguments interface{} `json:"arguments,omitempty"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
 
 	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	Command string `json:"command"`
 }
 
 // Response Response for a request.
 type Response struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+	ProtocolMessage
+
+	// Contains request result if success is true and error details if success is false.
+	Body json.RawMessage `json:"body,omitempty"`
 
 	// The command requested.
-	Command string `json:"command,omitempty"`
+	Command string `json:"command"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
+	// Contains the raw error in short form if `success` is false.
+	// This raw error might be interpreted by the client and is not shown in the UI.
 	// Some predefined values exist.
 	// Values:
-	// 'cancelled': request was cancelled.
+	// 'cancelled': the request was cancelled.
+	// 'notStopped': the request may be retried once the adapter is in a 'stopped' state.
 	// etc.
-	Message string `json:"message,omitempty"`
+	Message ResponseError `json:"message,omitempty"`
 
 	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	RequestSeq int `json:"request_seq"`
 
 	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// If true, the request was successful and the `body` attribute may contain the result of the request.
+	// If the value is false, the attribute `message` contains the error in short form and the `body` may contain additional information (see `ErrorResponse.body.error`).
+	Success bool `json:"success"`
 }
 
-// RestartArguments Arguments for 'restart' request.
+// ResponseError Contains the raw error in short form if `success` is false.
+// This raw error might be interpreted by the client and is not shown in the UI.
+// Some predefined values exist.
+type ResponseError string
+
+const (
+	// ResponseErrorCancelled the request was cancelled.
+	ResponseErrorCancelled ResponseError = "cancelled"
+
+	// ResponseErrorNotStopped the request may be retried once the adapter is in a 'stopped' state.
+	ResponseErrorNotStopped ResponseError = "notStopped"
+)
+
+// RestartArguments Arguments for `restart` request.
 type RestartArguments struct {
+	// The latest version of the `launch` or `attach` configuration.
+	Arguments json.RawMessage `json:"arguments,omitempty"`
 }
 
-// RestartFrameArguments Arguments for 'restartFrame' request.
+// RestartFrameArguments Arguments for `restartFrame` request.
 type RestartFrameArguments struct {
-	// Restart this stackframe.
-	FrameId float64 `json:"frameId,omitempty"`
+	// Restart the stack frame identified by `frameId`. The `frameId` must have been obtained in the current suspended state. See 'Lifetime of Object References' in the Overview section for details.
+	FrameId int `json:"frameId"`
 }
 
 // RestartFrameRequest RestartFrame request; value of command field is 'restartFrame'.
-// The request restarts execution of the specified stackframe.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'restart') after the restart has completed.
+// The request restarts execution of the specified stack frame.
+// The debug adapter first sends the response and then a `stopped` event (with reason `restart`) after the restart has completed.
+// Clients should only call this request if the corresponding capability `supportsRestartFrame` is true.
 type RestartFrameRequest struct {
-	// Object containing arguments for the command.
-	Arguments *RestartFrameArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *RestartFrameArguments `json:"arguments"`
 }
 
-// RestartFrameResponse Response to 'restartFrame' request. This is just an acknowledgement, so no body field is required.
-type RestartFrameResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *RestartFrameRequest) GetArguments() interface{} { return m.Arguments }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// RestartFrameResponse Response to `restartFrame` request. This is just an acknowledgement, so no body field is required.
+type RestartFrameResponse struct {
+	Response
 }
 
 // RestartRequest Restart request; value of command field is 'restart'.
-// Restarts a debug session. If the capability 'supportsRestartRequest' is missing or has the value false,
-// the client will implement 'restart' by terminating the debug adapter first and then launching it anew.
-// A debug adapter can override this default behaviour by implementing a restart request
-// and setting the capability 'supportsRestartRequest' to true.
+// Restarts a debug session. Clients should only call this request if the corresponding capability `supportsRestartRequest` is true.
+// If the capability is missing or has the value false, a typical client emulates `restart` by terminating the debug adapter first and then launching it anew.
 type RestartRequest struct {
+	Request
+
 	// Object containing arguments for the command.
 	Arguments *RestartArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
 }
 
-// RestartResponse Response to 'restart' request. This is just an acknowledgement, so no body field is required.
-type RestartResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *RestartRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// RestartResponse Response to `restart` request. This is just an acknowledgement, so no body field is required.
+type RestartResponse struct {
+	Response
 }
 
-// ReverseContinueArguments Arguments for 'reverseContinue' request.
+// ReverseContinueArguments Arguments for `reverseContinue` request.
 type ReverseContinueArguments struct {
-	// Execute 'reverseContinue' for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// If this flag is true, backward execution is resumed only for the thread with given `threadId`.
+	SingleThread bool `json:"singleThread,omitempty"`
+
+	// Specifies the active thread. If the debug adapter supports single thread execution (see `supportsSingleThreadExecutionRequests`) and the `singleThread` argument is true, only the thread with this ID is resumed.
+	ThreadId int `json:"threadId"`
 }
 
 // ReverseContinueRequest ReverseContinue request; value of command field is 'reverseContinue'.
-// The request starts the debuggee to run backward. Clients should only call this request if the capability 'supportsStepBack' is true.
+// The request resumes backward execution of all threads. If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true resumes only the specified thread. If not all threads were resumed, the `allThreadsContinued` attribute of the response should be set to false.
+// Clients should only call this request if the corresponding capability `supportsStepBack` is true.
 type ReverseContinueRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ReverseContinueArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ReverseContinueArguments `json:"arguments"`
 }
 
-// ReverseContinueResponse Response to 'reverseContinue' request. This is just an acknowledgement, so no body field is required.
-type ReverseContinueResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ReverseContinueRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ReverseContinueResponse Response to `reverseContinue` request. This is just an acknowledgement, so no body field is required.
+type ReverseContinueResponse struct {
+	Response
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// RunInTerminalRequest RunInTerminal request; value of command field is 'runInTerminal'.
+// This request is sent from the debug adapter to the client to run a command in a terminal.
+// This is typically used to launch the debuggee in a terminal provided by the client.
+// This request should only be called if the corresponding client capability `supportsRunInTerminalRequest` is true.
+// Client implementations of `runInTerminal` are free to run the command however they choose including issuing the command to a command line interpreter (aka 'shell'). Argument strings passed to the `runInTerminal` request must arrive verbatim in the command to be run. As a consequence, clients which use a shell are responsible for escaping any special shell characters in the argument strings to prevent them from being interpreted (and modified) by the shell.
+// Some users may wish to take advantage of shell processing in the argument strings. For clients which implement `runInTerminal` using an intermediary shell, the `argsCanBeInterpretedByShell` property can be set to true. In this case the client is requested not to escape any special shell characters in the argument strings.
+type RunInTerminalRequest struct {
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *RunInTerminalRequestArguments `json:"arguments"`
 }
 
-// RunInTerminalRequestArguments Arguments for 'runInTerminal' request.
+// GetArguments implements RequestMessage.
+func (m *RunInTerminalRequest) GetArguments() interface{} { return m.Arguments }
+
+// RunInTerminalRequestArguments Arguments for `runInTerminal` request.
 type RunInTerminalRequestArguments struct {
 	// List of arguments. The first argument is the command to run.
-	Args []string `json:"args,omitempty"`
+	Args []string `json:"args"`
+
+	// This property should only be set if the corresponding capability `supportsArgsCanBeInterpretedByShell` is true. If the client uses an intermediary shell to launch the application, then the client must not attempt to escape characters with special meanings for the shell. The user is fully responsible for escaping as needed and that arguments using special characters may not be portable across shells.
+	ArgsCanBeInterpretedByShell bool `json:"argsCanBeInterpretedByShell,omitempty"`
 
-	// Working directory of the command.
-	Cwd string `json:"cwd,omitempty"`
+	// Working directory for the command. For non-empty, valid paths this typically results in execution of a change directory command.
+	Cwd string `json:"cwd"`
 
 	// Environment key-value pairs that are added to or removed from the default environment.
 	Env map[string]string `json:"env,omitempty"`
 
-	// What kind of terminal to launch.
-	Kind string `json:"kind,omitempty"`
+	// What kind of terminal to launch. Defaults to `integrated` if not specified.
+	Kind RunInTerminalRequestArgumentsKind `json:"kind,omitempty"`
 
-	// Optional title of the terminal.
+	// Title of the terminal.
 	Title string `json:"title,omitempty"`
-}
-
-// RunInTerminalRequest RunInTerminal request; value of command field is 'runInTerminal'.
-// This request is sent from the debug adapter to the client to run a command in a terminal. This is typically used to launch the debuggee in a terminal provided by the client.
-type RunInTerminalRequest struct {
-	// Object containing arguments for the command.
-	Arguments *RunInTerminalRequestArguments `json:"arguments,omitempty"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// RunInTerminalRequestArgumentsKind What kind of terminal to launch. Defaults to `integrated` if not specified.
+type RunInTerminalRequestArgumentsKind string
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+const (
+	RunInTerminalRequestArgumentsKindIntegrated RunInTerminalRequestArgumentsKind = "integrated"
+	RunInTerminalRequestArgumentsKindExternal   RunInTerminalRequestArgumentsKind = "external"
+)
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// valid reports whether e is one of the values of RunInTerminalRequestArgumentsKind.
+func (e RunInTerminalRequestArgumentsKind) valid() bool {
+	switch e {
+	case RunInTerminalRequestArgumentsKindIntegrated, RunInTerminalRequestArgumentsKindExternal:
+		return true
+	}
+	return false
 }
 
-// RunInTerminalResponse Response to 'runInTerminal' request.
+// RunInTerminalResponse Response to `runInTerminal` request.
 type RunInTerminalResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	Response
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *RunInTerminalResponseBody `json:"body"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *RunInTerminalResponse) GetBody() interface{} { return m.Body }
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// RunInTerminalResponseBody Contains request result if success is true and error details if success is false.
+type RunInTerminalResponseBody struct {
+	// The process ID. The value should be less than or equal to 2147483647 (2^31-1).
+	ProcessId int `json:"processId,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The process ID of the terminal shell. The value should be less than or equal to 2147483647 (2^31-1).
+	ShellProcessId int `json:"shellProcessId,omitempty"`
 }
 
-// Scope A Scope is a named container for variables. Optionally a scope can map to a source or a range within a source.
+// Scope A `Scope` is a named container for variables. Optionally a scope can map to a source or a range within a source.
 type Scope struct {
-	// Optional start column of the range covered by this scope.
-	Column float64 `json:"column,omitempty"`
+	// Start position of the range covered by the scope. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
-	// Optional end column of the range covered by this scope.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// End position of the range covered by the scope. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// Optional end line of the range covered by this scope.
-	EndLine float64 `json:"endLine,omitempty"`
+	// The end line of the range covered by this scope.
+	EndLine int `json:"endLine,omitempty"`
 
 	// If true, the number of variables in this scope is large or expensive to retrieve.
-	Expensive bool `json:"expensive,omitempty"`
+	Expensive bool `json:"expensive"`
 
 	// The number of indexed variables in this scope.
-	// The client can use this optional information to present the variables in a paged UI and fetch them in chunks.
-	IndexedVariables float64 `json:"indexedVariables,omitempty"`
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	IndexedVariables int `json:"indexedVariables,omitempty"`
 
-	// Optional start line of the range covered by this scope.
-	Line float64 `json:"line,omitempty"`
+	// The start line of the range covered by this scope.
+	Line int `json:"line,omitempty"`
 
 	// Name of the scope such as 'Arguments', 'Locals', or 'Registers'. This string is shown in the UI as is and can be translated.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 
 	// The number of named variables in this scope.
-	// The client can use this optional information to present the variables in a paged UI and fetch them in chunks.
-	NamedVariables float64 `json:"namedVariables,omitempty"`
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	NamedVariables int `json:"namedVariables,omitempty"`
 
-	// An optional hint for how to present this scope in the UI. If this attribute is missing, the scope is shown with a generic UI.
+	// A hint for how to present this scope in the UI. If this attribute is missing, the scope is shown with a generic UI.
 	// Values:
 	// 'arguments': Scope contains method arguments.
 	// 'locals': Scope contains local variables.
-	// 'registers': Scope contains registers. Only a single 'registers' scope should be returned from a 'scopes' request.
+	// 'registers': Scope contains registers. Only a single `registers` scope should be returned from a `scopes` request.
+	// 'returnValue': Scope contains one or more return values.
 	// etc.
-	PresentationHint string `json:"presentationHint,omitempty"`
+	PresentationHint ScopePresentationHint `json:"presentationHint,omitempty"`
 
-	// Optional source for this scope.
+	// The source for this scope.
 	Source *Source `json:"source,omitempty"`
 
-	// The variables of this scope can be retrieved by passing the value of variablesReference to the VariablesRequest.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	// The variables of this scope can be retrieved by passing the value of `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference"`
 }
 
-// ScopesArguments Arguments for 'scopes' request.
-type ScopesArguments struct {
-	// Retrieve the scopes for this stackframe.
-	FrameId float64 `json:"frameId,omitempty"`
-}
+// ScopePresentationHint A hint for how to present this scope in the UI. If this attribute is missing, the scope is shown with a generic UI.
+type ScopePresentationHint string
 
-// ScopesRequest Scopes request; value of command field is 'scopes'.
-// The request returns the variable scopes for a given stackframe ID.
-type ScopesRequest struct {
-	// Object containing arguments for the command.
-	Arguments *ScopesArguments `json:"arguments,omitempty"`
+const (
+	// ScopePresentationHintArguments Scope contains method arguments.
+	ScopePresentationHintArguments ScopePresentationHint = "arguments"
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	// ScopePresentationHintLocals Scope contains local variables.
+	ScopePresentationHintLocals ScopePresentationHint = "locals"
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// ScopePresentationHintRegisters Scope contains registers. Only a single `registers` scope should be returned from a `scopes` request.
+	ScopePresentationHintRegisters ScopePresentationHint = "registers"
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// ScopePresentationHintReturnValue Scope contains one or more return values.
+	ScopePresentationHintReturnValue ScopePresentationHint = "returnValue"
+)
+
+// ScopesArguments Arguments for `scopes` request.
+type ScopesArguments struct {
+	// Retrieve the scopes for the stack frame identified by `frameId`. The `frameId` must have been obtained in the current suspended state. See 'Lifetime of Object References' in the Overview section for details.
+	FrameId int `json:"frameId"`
 }
 
-// ScopesResponse Response to 'scopes' request.
-type ScopesResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// ScopesRequest Scopes request; value of command field is 'scopes'.
+// The request returns the variable scopes for a given stack frame ID.
+type ScopesRequest struct {
+	Request
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *ScopesArguments `json:"arguments"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *ScopesRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// ScopesResponse Response to `scopes` request.
+type ScopesResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ScopesResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ScopesResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ScopesResponseBody Contains request result if success is true and error details if success is false.
+type ScopesResponseBody struct {
+	// The scopes of the stack frame. If the array has length zero, there are no scopes available.
+	Scopes []*Scope `json:"scopes"`
 }
 
-// SetBreakpointsArguments Arguments for 'setBreakpoints' request.
+// SetBreakpointsArguments Arguments for `setBreakpoints` request.
 type SetBreakpointsArguments struct {
 	// The code locations of the breakpoints.
 	Breakpoints []*SourceBreakpoint `json:"breakpoints,omitempty"`
 
 	// Deprecated: The code locations of the breakpoints.
-	Lines []float64 `json:"lines,omitempty"`
+	Lines []int `json:"lines,omitempty"`
 
-	// The source location of the breakpoints; either 'source.path' or 'source.reference' must be specified.
-	Source *Source `json:"source,omitempty"`
+	// The source location of the breakpoints; either `source.path` or `source.sourceReference` must be specified.
+	Source *Source `json:"source"`
 
 	// A value of true indicates that the underlying source has been modified which results in new breakpoint locations.
 	SourceModified bool `json:"sourceModified,omitempty"`
@@ -2258,501 +2510,516 @@ type SetBreakpointsArguments struct {
 // SetBreakpointsRequest SetBreakpoints request; value of command field is 'setBreakpoints'.
 // Sets multiple breakpoints for a single source and clears all previous breakpoints in that source.
 // To clear all breakpoint for a source, specify an empty array.
-// When a breakpoint is hit, a 'stopped' event (with reason 'breakpoint') is generated.
+// When a breakpoint is hit, a `stopped` event (with reason `breakpoint`) is generated.
 type SetBreakpointsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *SetBreakpointsArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *SetBreakpointsArguments `json:"arguments"`
 }
 
-// SetBreakpointsResponse Response to 'setBreakpoints' request.
+// GetArguments implements RequestMessage.
+func (m *SetBreakpointsRequest) GetArguments() interface{} { return m.Arguments }
+
+// SetBreakpointsResponse Response to `setBreakpoints` request.
 // Returned is information about each breakpoint created by this request.
 // This includes the actual code location and whether the breakpoint could be verified.
-// The breakpoints returned are in the same order as the elements of the 'breakpoints'
-// (or the deprecated 'lines') array in the arguments.
+// The breakpoints returned are in the same order as the elements of the `breakpoints`
+// (or the deprecated `lines`) array in the arguments.
 type SetBreakpointsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	Response
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetBreakpointsResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetBreakpointsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// SetBreakpointsResponseBody Contains request result if success is true and error details if success is false.
+type SetBreakpointsResponseBody struct {
+	// Information about the breakpoints.
+	// The array elements are in the same order as the elements of the `breakpoints` (or the deprecated `lines`) array in the arguments.
+	Breakpoints []*Breakpoint `json:"breakpoints"`
 }
 
-// SetDataBreakpointsArguments Arguments for 'setDataBreakpoints' request.
+// SetDataBreakpointsArguments Arguments for `setDataBreakpoints` request.
 type SetDataBreakpointsArguments struct {
 	// The contents of this array replaces all existing data breakpoints. An empty array clears all data breakpoints.
-	Breakpoints []*DataBreakpoint `json:"breakpoints,omitempty"`
+	Breakpoints []*DataBreakpoint `json:"breakpoints"`
 }
 
 // SetDataBreakpointsRequest SetDataBreakpoints request; value of command field is 'setDataBreakpoints'.
 // Replaces all existing data breakpoints with new data breakpoints.
 // To clear all data breakpoints, specify an empty array.
-// When a data breakpoint is hit, a 'stopped' event (with reason 'data breakpoint') is generated.
+// When a data breakpoint is hit, a `stopped` event (with reason `data breakpoint`) is generated.
+// Clients should only call this request if the corresponding capability `supportsDataBreakpoints` is true.
 type SetDataBreakpointsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *SetDataBreakpointsArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *SetDataBreakpointsArguments `json:"arguments"`
 }
 
-// SetDataBreakpointsResponse Response to 'setDataBreakpoints' request.
+// GetArguments implements RequestMessage.
+func (m *SetDataBreakpointsRequest) GetArguments() interface{} { return m.Arguments }
+
+// SetDataBreakpointsResponse Response to `setDataBreakpoints` request.
 // Returned is information about each breakpoint created by this request.
 type SetDataBreakpointsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+	Response
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetDataBreakpointsResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetDataBreakpointsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// SetDataBreakpointsResponseBody Contains request result if success is true and error details if success is false.
+type SetDataBreakpointsResponseBody struct {
+	// Information about the data breakpoints. The array elements correspond to the elements of the input argument `breakpoints` array.
+	Breakpoints []*Breakpoint `json:"breakpoints"`
 }
 
-// SetExceptionBreakpointsArguments Arguments for 'setExceptionBreakpoints' request.
+// SetExceptionBreakpointsArguments Arguments for `setExceptionBreakpoints` request.
 type SetExceptionBreakpointsArguments struct {
 	// Configuration options for selected exceptions.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsExceptionOptions` is true.
 	ExceptionOptions []*ExceptionOptions `json:"exceptionOptions,omitempty"`
 
-	// IDs of checked exception options. The set of IDs is returned via the 'exceptionBreakpointFilters' capability.
-	Filters []string `json:"filters,omitempty"`
+	// Set of exception filters and their options. The set of all possible exception filters is defined by the `exceptionBreakpointFilters` capability. This attribute is only honored by a debug adapter if the corresponding capability `supportsExceptionFilterOptions` is true. The `filter` and `filterOptions` sets are additive.
+	FilterOptions []*ExceptionFilterOptions `json:"filterOptions,omitempty"`
+
+	// Set of exception filters specified by their ID. The set of all possible exception filters is defined by the `exceptionBreakpointFilters` capability. The `filter` and `filterOptions` sets are additive.
+	Filters []string `json:"filters"`
 }
 
 // SetExceptionBreakpointsRequest SetExceptionBreakpoints request; value of command field is 'setExceptionBreakpoints'.
-// The request configures the debuggers response to thrown exceptions. If an exception is configured to break, a 'stopped' event is fired (with reason 'exception').
+// The request configures the debugger's response to thrown exceptions. Each of the `filters`, `filterOptions`, and `exceptionOptions` in the request are independent configurations to a debug adapter indicating a kind of exception to catch. An exception thrown in a program should result in a `stopped` event from the debug adapter (with reason `exception`) if any of the configured filters match.
+// Clients should only call this request if the corresponding capability `exceptionBreakpointFilters` returns one or more filters.
 type SetExceptionBreakpointsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *SetExceptionBreakpointsArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *SetExceptionBreakpointsArguments `json:"arguments"`
 }
 
-// SetExceptionBreakpointsResponse Response to 'setExceptionBreakpoints' request. This is just an acknowledgement, so no body field is required.
-type SetExceptionBreakpointsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SetExceptionBreakpointsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// SetExceptionBreakpointsResponse Response to `setExceptionBreakpoints` request.
+// The response contains an array of `Breakpoint` objects with information about each exception breakpoint or filter. The `Breakpoint` objects are in the same order as the elements of the `filters`, `filterOptions`, `exceptionOptions` arrays given as arguments. If both `filters` and `filterOptions` are given, the returned array must start with `filters` information first, followed by `filterOptions` information.
+// The `verified` property of a `Breakpoint` object signals whether the exception breakpoint or filter could be successfully created and whether the condition is valid. In case of an error the `message` property explains the problem. The `id` property can be used to introduce a unique ID for the exception breakpoint or filter so that it can be updated subsequently by sending breakpoint events.
+// For backward compatibility both the `breakpoints` array and the enclosing `body` are optional. If these elements are missing a client is not able to show problems for individual exception breakpoints or filters.
+type SetExceptionBreakpointsResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetExceptionBreakpointsResponseBody `json:"body,omitempty"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetExceptionBreakpointsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// SetExceptionBreakpointsResponseBody Contains request result if success is true and error details if success is false.
+type SetExceptionBreakpointsResponseBody struct {
+	// Information about the exception breakpoints or filters.
+	// The breakpoints returned are in the same order as the elements of the `filters`, `filterOptions`, `exceptionOptions` arrays in the arguments. If both `filters` and `filterOptions` are given, the returned array must start with `filters` information first, followed by `filterOptions` information.
+	Breakpoints []*Breakpoint `json:"breakpoints,omitempty"`
 }
 
-// SetExpressionArguments Arguments for 'setExpression' request.
+// SetExpressionArguments Arguments for `setExpression` request.
 type SetExpressionArguments struct {
 	// The l-value expression to assign to.
-	Expression string `json:"expression,omitempty"`
+	Expression string `json:"expression"`
 
 	// Specifies how the resulting value should be formatted.
 	Format *ValueFormat `json:"format,omitempty"`
 
 	// Evaluate the expressions in the scope of this stack frame. If not specified, the expressions are evaluated in the global scope.
-	FrameId float64 `json:"frameId,omitempty"`
+	FrameId int `json:"frameId,omitempty"`
 
 	// The value expression to assign to the l-value expression.
-	Value string `json:"value,omitempty"`
+	Value string `json:"value"`
 }
 
 // SetExpressionRequest SetExpression request; value of command field is 'setExpression'.
-// Evaluates the given 'value' expression and assigns it to the 'expression' which must be a modifiable l-value.
+// Evaluates the given `value` expression and assigns it to the `expression` which must be a modifiable l-value.
 // The expressions have access to any variables and arguments that are in scope of the specified frame.
+// Clients should only call this request if the corresponding capability `supportsSetExpression` is true.
+// If a debug adapter implements both `setExpression` and `setVariable`, a client uses `setExpression` if the variable has an `evaluateName` property.
 type SetExpressionRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *SetExpressionArguments `json:"arguments,omitempty"`
+	Arguments *SetExpressionArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SetExpressionRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// SetExpressionResponse Response to `setExpression` request.
+type SetExpressionResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetExpressionResponseBody `json:"body"`
 }
 
-// SetExpressionResponse Response to 'setExpression' request.
-type SetExpressionResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetExpressionResponse) GetBody() interface{} { return m.Body }
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// SetExpressionResponseBody Contains request result if success is true and error details if success is false.
+type SetExpressionResponseBody struct {
+	// The number of indexed child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	IndexedVariables int `json:"indexedVariables,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// A memory reference to a location appropriate for this result.
+	// For pointer type eval results, this is generally a reference to the memory address contained in the pointer.
+	// This attribute may be returned by a debug adapter if corresponding capability `supportsMemoryReferences` is true.
+	MemoryReference string `json:"memoryReference,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// The number of named child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	NamedVariables int `json:"namedVariables,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// Properties of a value that can be used to determine how to render the result in the UI.
+	PresentationHint *VariablePresentationHint `json:"presentationHint,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
+	// The type of the value.
+	// This attribute should only be returned by a debug adapter if the corresponding capability `supportsVariableType` is true.
 	Type string `json:"type,omitempty"`
+
+	// The new value of the expression.
+	Value string `json:"value"`
+
+	// A reference that allows the client to request the location where the new value is declared. For example, if the new value is function pointer, the adapter may be able to look up the function's location. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	ValueLocationReference int `json:"valueLocationReference,omitempty"`
+
+	// If `variablesReference` is > 0, the evaluate result is structured and its children can be retrieved by passing `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
-// SetFunctionBreakpointsArguments Arguments for 'setFunctionBreakpoints' request.
+// SetFunctionBreakpointsArguments Arguments for `setFunctionBreakpoints` request.
 type SetFunctionBreakpointsArguments struct {
 	// The function names of the breakpoints.
-	Breakpoints []*FunctionBreakpoint `json:"breakpoints,omitempty"`
+	Breakpoints []*FunctionBreakpoint `json:"breakpoints"`
 }
 
 // SetFunctionBreakpointsRequest SetFunctionBreakpoints request; value of command field is 'setFunctionBreakpoints'.
 // Replaces all existing function breakpoints with new function breakpoints.
 // To clear all function breakpoints, specify an empty array.
-// When a function breakpoint is hit, a 'stopped' event (with reason 'function breakpoint') is generated.
+// When a function breakpoint is hit, a `stopped` event (with reason `function breakpoint`) is generated.
+// Clients should only call this request if the corresponding capability `supportsFunctionBreakpoints` is true.
 type SetFunctionBreakpointsRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *SetFunctionBreakpointsArguments `json:"arguments,omitempty"`
+	Arguments *SetFunctionBreakpointsArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SetFunctionBreakpointsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// SetFunctionBreakpointsResponse Response to `setFunctionBreakpoints` request.
+// Returned is information about each breakpoint created by this request.
+type SetFunctionBreakpointsResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetFunctionBreakpointsResponseBody `json:"body"`
 }
 
-// SetFunctionBreakpointsResponse Response to 'setFunctionBreakpoints' request.
-// Returned is information about each breakpoint created by this request.
-type SetFunctionBreakpointsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetFunctionBreakpointsResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// SetFunctionBreakpointsResponseBody Contains request result if success is true and error details if success is false.
+type SetFunctionBreakpointsResponseBody struct {
+	// Information about the breakpoints. The array elements correspond to the elements of the `breakpoints` array.
+	Breakpoints []*Breakpoint `json:"breakpoints"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// SetInstructionBreakpointsArguments Arguments for `setInstructionBreakpoints` request
+type SetInstructionBreakpointsArguments struct {
+	// The instruction references of the breakpoints
+	Breakpoints []*InstructionBreakpoint `json:"breakpoints"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// SetInstructionBreakpointsRequest SetInstructionBreakpoints request; value of command field is 'setInstructionBreakpoints'.
+// Replaces all existing instruction breakpoints. Typically, instruction breakpoints would be set from a disassembly window.
+// To clear all instruction breakpoints, specify an empty array.
+// When an instruction breakpoint is hit, a `stopped` event (with reason `instruction breakpoint`) is generated.
+// Clients should only call this request if the corresponding capability `supportsInstructionBreakpoints` is true.
+type SetInstructionBreakpointsRequest struct {
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *SetInstructionBreakpointsArguments `json:"arguments"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SetInstructionBreakpointsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// SetInstructionBreakpointsResponse Response to `setInstructionBreakpoints` request
+type SetInstructionBreakpointsResponse struct {
+	Response
+
+	// Contains request result if success is true and error details if success is false.
+	Body *SetInstructionBreakpointsResponseBody `json:"body"`
+}
+
+// GetBody implements ResponseMessage.
+func (m *SetInstructionBreakpointsResponse) GetBody() interface{} { return m.Body }
+
+// SetInstructionBreakpointsResponseBody Contains request result if success is true and error details if success is false.
+type SetInstructionBreakpointsResponseBody struct {
+	// Information about the breakpoints. The array elements correspond to the elements of the `breakpoints` array.
+	Breakpoints []*Breakpoint `json:"breakpoints"`
 }
 
-// SetVariableArguments Arguments for 'setVariable' request.
+// SetVariableArguments Arguments for `setVariable` request.
 type SetVariableArguments struct {
 	// Specifies details on how to format the response value.
 	Format *ValueFormat `json:"format,omitempty"`
 
 	// The name of the variable in the container.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 
 	// The value of the variable.
-	Value string `json:"value,omitempty"`
+	Value string `json:"value"`
 
-	// The reference of the variable container.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	// The reference of the variable container. The `variablesReference` must have been obtained in the current suspended state. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference"`
 }
 
 // SetVariableRequest SetVariable request; value of command field is 'setVariable'.
-// Set the variable with the given name in the variable container to a new value.
+// Set the variable with the given name in the variable container to a new value. Clients should only call this request if the corresponding capability `supportsSetVariable` is true.
+// If a debug adapter implements both `setVariable` and `setExpression`, a client will only use `setExpression` if the variable has an `evaluateName` property.
 type SetVariableRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *SetVariableArguments `json:"arguments,omitempty"`
+	Arguments *SetVariableArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SetVariableRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// SetVariableResponse Response to `setVariable` request.
+type SetVariableResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SetVariableResponseBody `json:"body"`
 }
 
-// SetVariableResponse Response to 'setVariable' request.
-type SetVariableResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SetVariableResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// SetVariableResponseBody Contains request result if success is true and error details if success is false.
+type SetVariableResponseBody struct {
+	// The number of indexed child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	IndexedVariables int `json:"indexedVariables,omitempty"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// A memory reference to a location appropriate for this result.
+	// For pointer type eval results, this is generally a reference to the memory address contained in the pointer.
+	// This attribute may be returned by a debug adapter if corresponding capability `supportsMemoryReferences` is true.
+	MemoryReference string `json:"memoryReference,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// The number of named child variables.
+	// The client can use this information to present the variables in a paged UI and fetch them in chunks.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	NamedVariables int `json:"namedVariables,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// The type of the new value. Typically shown in the UI when hovering over the value.
+	Type string `json:"type,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The new value of the variable.
+	Value string `json:"value"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// A reference that allows the client to request the location where the new value is declared. For example, if the new value is function pointer, the adapter may be able to look up the function's location. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	ValueLocationReference int `json:"valueLocationReference,omitempty"`
+
+	// If `variablesReference` is > 0, the new value is structured and its children can be retrieved by passing `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	//
+	// If this property is included in the response, any `variablesReference` previously associated with the updated variable, and those of its children, are no longer valid.
+	VariablesReference int `json:"variablesReference,omitempty"`
 }
 
-// Source A Source is a descriptor for source code. It is returned from the debug adapter as part of a StackFrame and it is used by clients when specifying breakpoints.
+// Source A `Source` is a descriptor for source code.
+// It is returned from the debug adapter as part of a `StackFrame` and it is used by clients when specifying breakpoints.
 type Source struct {
-	// Optional data that a debug adapter might want to loop through the client. The client should leave the data intact and persist it across sessions. The client should not interpret the data.
+	// Additional data that a debug adapter might want to loop through the client.
+	// The client should leave the data intact and persist it across sessions. The client should not interpret the data.
 	AdapterData interface{} `json:"adapterData,omitempty"`
 
 	// The checksums associated with this file.
 	Checksums []*Checksum `json:"checksums,omitempty"`
 
-	// The short name of the source. Every source returned from the debug adapter has a name. When sending a source to the debug adapter this name is optional.
+	// The short name of the source. Every source returned from the debug adapter has a name.
+	// When sending a source to the debug adapter this name is optional.
 	Name string `json:"name,omitempty"`
 
-	// The (optional) origin of this source: possible values 'internal module', 'inlined content from source map', etc.
+	// The origin of this source. For example, 'internal module', 'inlined content from source map', etc.
 	Origin string `json:"origin,omitempty"`
 
-	// The path of the source to be shown in the UI. It is only used to locate and load the content of the source if no sourceReference is specified (or its value is 0).
+	// The path of the source to be shown in the UI.
+	// It is only used to locate and load the content of the source if no `sourceReference` is specified (or its value is 0).
 	Path string `json:"path,omitempty"`
 
-	// An optional hint for how to present the source in the UI. A value of 'deemphasize' can be used to indicate that the source is not available or that it is skipped on stepping.
-	PresentationHint string `json:"presentationHint,omitempty"`
+	// A hint for how to present the source in the UI.
+	// A value of `deemphasize` can be used to indicate that the source is not available or that it is skipped on stepping.
+	PresentationHint SourcePresentationHint `json:"presentationHint,omitempty"`
 
-	// If sourceReference > 0 the contents of the source must be retrieved through the SourceRequest (even if a path is specified). A sourceReference is only valid for a session, so it must not be used to persist a source. The value should be less than or equal to 2147483647 (2^31 - 1).
-	SourceReference float64 `json:"sourceReference,omitempty"`
+	// If the value > 0 the contents of the source must be retrieved through the `source` request (even if a path is specified).
+	// Since a `sourceReference` is only valid for a session, it can not be used to persist a source.
+	// The value should be less than or equal to 2147483647 (2^31-1).
+	SourceReference int `json:"sourceReference,omitempty"`
 
-	// An optional list of sources that are related to this source. These may be the source that generated this source.
+	// A list of sources that are related to this source. These may be the source that generated this source.
 	Sources []*Source `json:"sources,omitempty"`
 }
 
-// SourceArguments Arguments for 'source' request.
+// SourceArguments Arguments for `source` request.
 type SourceArguments struct {
-	// Specifies the source content to load. Either source.path or source.sourceReference must be specified.
+	// Specifies the source content to load. Either `source.path` or `source.sourceReference` must be specified.
 	Source *Source `json:"source,omitempty"`
 
-	// The reference to the source. This is the same as source.sourceReference. This is provided for backward compatibility since old backends do not understand the 'source' attribute.
-	SourceReference float64 `json:"sourceReference,omitempty"`
+	// The reference to the source. This is the same as `source.sourceReference`.
+	// This is provided for backward compatibility since old clients do not understand the `source` attribute.
+	SourceReference int `json:"sourceReference"`
 }
 
-// SourceBreakpoint Properties of a breakpoint or logpoint passed to the setBreakpoints request.
+// SourceBreakpoint Properties of a breakpoint or logpoint passed to the `setBreakpoints` request.
 type SourceBreakpoint struct {
-	// An optional source column of the breakpoint.
-	Column float64 `json:"column,omitempty"`
+	// Start position within source line of the breakpoint or logpoint. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
-	// An optional expression for conditional breakpoints.
+	// The expression for conditional breakpoints.
+	// It is only honored by a debug adapter if the corresponding capability `supportsConditionalBreakpoints` is true.
 	Condition string `json:"condition,omitempty"`
 
-	// An optional expression that controls how many hits of the breakpoint are ignored. The backend is expected to interpret the expression as needed.
+	// The expression that controls how many hits of the breakpoint are ignored.
+	// The debug adapter is expected to interpret the expression as needed.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsHitConditionalBreakpoints` is true.
+	// If both this property and `condition` are specified, `hitCondition` should be evaluated only if the `condition` is met, and the debug adapter should stop only if both conditions are met.
 	HitCondition string `json:"hitCondition,omitempty"`
 
 	// The source line of the breakpoint or logpoint.
-	Line float64 `json:"line,omitempty"`
+	Line int `json:"line"`
 
-	// If this attribute exists and is non-empty, the backend must not 'break' (stop) but log the message instead. Expressions within {} are interpolated.
+	// If this attribute exists and is non-empty, the debug adapter must not 'break' (stop)
+	// but log the message instead. Expressions within `{}` are interpolated.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsLogPoints` is true.
+	// If either `hitCondition` or `condition` is specified, then the message should only be logged if those conditions are met.
 	LogMessage string `json:"logMessage,omitempty"`
+
+	// The mode of this breakpoint. If defined, this must be one of the `breakpointModes` the debug adapter advertised in its `Capabilities`.
+	Mode string `json:"mode,omitempty"`
+}
+
+// SourcePresentationHint A hint for how to present the source in the UI.
+// A value of `deemphasize` can be used to indicate that the source is not available or that it is skipped on stepping.
+type SourcePresentationHint string
+
+const (
+	SourcePresentationHintNormal      SourcePresentationHint = "normal"
+	SourcePresentationHintEmphasize   SourcePresentationHint = "emphasize"
+	SourcePresentationHintDeemphasize SourcePresentationHint = "deemphasize"
+)
+
+// valid reports whether e is one of the values of SourcePresentationHint.
+func (e SourcePresentationHint) valid() bool {
+	switch e {
+	case SourcePresentationHintNormal, SourcePresentationHintEmphasize, SourcePresentationHintDeemphasize:
+		return true
+	}
+	return false
 }
 
 // SourceRequest Source request; value of command field is 'source'.
 // The request retrieves the source code for a given source reference.
 type SourceRequest struct {
-	// Object containing arguments for the command.
-	Arguments *SourceArguments `json:"arguments,omitempty"`
+	Request
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *SourceArguments `json:"arguments"`
 }
 
-// SourceResponse Response to 'source' request.
-type SourceResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *SourceRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// SourceResponse Response to `source` request.
+type SourceResponse struct {
+	Response
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *SourceResponseBody `json:"body"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *SourceResponse) GetBody() interface{} { return m.Body }
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// SourceResponseBody Contains request result if success is true and error details if success is false.
+type SourceResponseBody struct {
+	// Content of the source reference.
+	Content string `json:"content"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Content type (MIME type) of the source.
+	MimeType string `json:"mimeType,omitempty"`
 }
 
 // StackFrame A Stackframe contains the source location.
 type StackFrame struct {
-	// The column within the line. If source is null or doesn't exist, column is 0 and must be ignored.
-	Column float64 `json:"column,omitempty"`
+	// Indicates whether this frame can be restarted with the `restartFrame` request. Clients should only use this if the debug adapter supports the `restart` request and the corresponding capability `supportsRestartFrame` is true. If a debug adapter has this capability, then `canRestart` defaults to `true` if the property is absent.
+	CanRestart *bool `json:"canRestart,omitempty"`
+
+	// Start position of the range covered by the stack frame. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based. If attribute `source` is missing or doesn't exist, `column` is 0 and should be ignored by the client.
+	Column int `json:"column"`
 
-	// An optional end column of the range covered by the stack frame.
-	EndColumn float64 `json:"endColumn,omitempty"`
+	// End position of the range covered by the stack frame. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// An optional end line of the range covered by the stack frame.
-	EndLine float64 `json:"endLine,omitempty"`
+	// The end line of the range covered by the stack frame.
+	EndLine int `json:"endLine,omitempty"`
 
-	// An identifier for the stack frame. It must be unique across all threads. This id can be used to retrieve the scopes of the frame with the 'scopesRequest' or to restart the execution of a stackframe.
-	Id float64 `json:"id,omitempty"`
+	// An identifier for the stack frame. It must be unique across all threads.
+	// This id can be used to retrieve the scopes of the frame with the `scopes` request or to restart the execution of a stack frame.
+	Id int `json:"id"`
 
-	// Optional memory reference for the current instruction pointer in this frame.
+	// A memory reference for the current instruction pointer in this frame.
 	InstructionPointerReference string `json:"instructionPointerReference,omitempty"`
 
-	// The line within the file of the frame. If source is null or doesn't exist, line is 0 and must be ignored.
-	Line float64 `json:"line,omitempty"`
+	// The line within the source of the frame. If the source attribute is missing or doesn't exist, `line` is 0 and should be ignored by the client.
+	Line int `json:"line"`
 
 	// The module associated with this frame, if any.
 	ModuleId interface{} `json:"moduleId,omitempty"`
 
 	// The name of the stack frame, typically a method name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 
-	// An optional hint for how to present this frame in the UI. A value of 'label' can be used to indicate that the frame is an artificial frame that is used as a visual label or separator. A value of 'subtle' can be used to change the appearance of a frame in a 'subtle' way.
-	PresentationHint string `json:"presentationHint,omitempty"`
+	// A hint for how to present this frame in the UI.
+	// A value of `label` can be used to indicate that the frame is an artificial frame that is used as a visual label or separator. A value of `subtle` can be used to change the appearance of a frame in a 'subtle' way.
+	PresentationHint StackFramePresentationHint `json:"presentationHint,omitempty"`
 
-	// The optional source of the frame.
+	// The source of the frame.
 	Source *Source `json:"source,omitempty"`
 }
 
 // StackFrameFormat Provides formatting information for a stack frame.
 type StackFrameFormat struct {
-	// Display the value in hex.
-	Hex bool `json:"hex,omitempty"`
+	ValueFormat
 
 	// Includes all stack frames, including those the debug adapter might otherwise hide.
 	IncludeAll bool `json:"includeAll,omitempty"`
@@ -2776,529 +3043,493 @@ type StackFrameFormat struct {
 	Parameters bool `json:"parameters,omitempty"`
 }
 
-// StackTraceArguments Arguments for 'stackTrace' request.
+// StackFramePresentationHint A hint for how to present this frame in the UI.
+// A value of `label` can be used to indicate that the frame is an artificial frame that is used as a visual label or separator. A value of `subtle` can be used to change the appearance of a frame in a 'subtle' way.
+type StackFramePresentationHint string
+
+const (
+	StackFramePresentationHintNormal StackFramePresentationHint = "normal"
+	StackFramePresentationHintLabel  StackFramePresentationHint = "label"
+	StackFramePresentationHintSubtle StackFramePresentationHint = "subtle"
+)
+
+// valid reports whether e is one of the values of StackFramePresentationHint.
+func (e StackFramePresentationHint) valid() bool {
+	switch e {
+	case StackFramePresentationHintNormal, StackFramePresentationHintLabel, StackFramePresentationHintSubtle:
+		return true
+	}
+	return false
+}
+
+// StackTraceArguments Arguments for `stackTrace` request.
 type StackTraceArguments struct {
 	// Specifies details on how to format the stack frames.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsValueFormattingOptions` is true.
 	Format *StackFrameFormat `json:"format,omitempty"`
 
 	// The maximum number of frames to return. If levels is not specified or 0, all frames are returned.
-	Levels float64 `json:"levels,omitempty"`
+	Levels int `json:"levels,omitempty"`
 
 	// The index of the first frame to return; if omitted frames start at 0.
-	StartFrame float64 `json:"startFrame,omitempty"`
+	StartFrame int `json:"startFrame,omitempty"`
 
 	// Retrieve the stacktrace for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	ThreadId int `json:"threadId"`
 }
 
 // StackTraceRequest StackTrace request; value of command field is 'stackTrace'.
-// The request returns a stacktrace from the current execution state.
+// The request returns a stacktrace from the current execution state of a given thread.
+// A client can request all stack frames by omitting the startFrame and levels arguments. For performance-conscious clients and if the corresponding capability `supportsDelayedStackTraceLoading` is true, stack frames can be retrieved in a piecemeal way with the `startFrame` and `levels` arguments. The response of the `stackTrace` request may contain a `totalFrames` property that hints at the total number of frames in the stack. If a client needs this total number upfront, it can issue a request for a single (first) frame and depending on the value of `totalFrames` decide how to proceed. In any case a client should be prepared to receive fewer frames than requested, which is an indication that the end of the stack has been reached.
 type StackTraceRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *StackTraceArguments `json:"arguments,omitempty"`
+	Arguments *StackTraceArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StackTraceRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// StackTraceResponse Response to `stackTrace` request.
+type StackTraceResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *StackTraceResponseBody `json:"body"`
 }
 
-// StackTraceResponse Response to 'stackTrace' request.
-type StackTraceResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *StackTraceResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// StackTraceResponseBody Contains request result if success is true and error details if success is false.
+type StackTraceResponseBody struct {
+	// The frames of the stack frame. If the array has length zero, there are no stack frames available.
+	// This means that there is no location information available.
+	StackFrames []*StackFrame `json:"stackFrames"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// The total number of frames available in the stack. If omitted or if `totalFrames` is larger than the available frames, a client is expected to request frames until a request returns less frames than requested (which indicates the end of the stack). Returning monotonically increasing `totalFrames` values for subsequent requests can be used to enforce paging in the client.
+	TotalFrames int `json:"totalFrames,omitempty"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// StartDebuggingRequest StartDebugging request; value of command field is 'startDebugging'.
+// This request is sent from the debug adapter to the client to start a new debug session of the same type as the caller.
+// This request should only be sent if the corresponding client capability `supportsStartDebuggingRequest` is true.
+// A client implementation of `startDebugging` should start a new debug session (of the same type as the caller) in the same way that the caller's session was started. If the client supports hierarchical debug sessions, the newly created session can be treated as a child of the caller session.
+type StartDebuggingRequest struct {
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *StartDebuggingRequestArguments `json:"arguments"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StartDebuggingRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+// StartDebuggingRequestArguments Arguments for `startDebugging` request.
+type StartDebuggingRequestArguments struct {
+	// Arguments passed to the new debug session. The arguments must only contain properties understood by the `launch` or `attach` requests of the debug adapter and they must not contain any client-specific properties (e.g. `type`) or client-specific features (e.g. substitutable 'variables').
+	Configuration map[string]interface{} `json:"configuration"`
 
-// StepBackArguments Arguments for 'stepBack' request.
-type StepBackArguments struct {
-	// Execute 'stepBack' for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// Indicates whether the new debug session should be started with a `launch` or `attach` request.
+	Request StartDebuggingRequestArgumentsRequest `json:"request"`
 }
 
-// StepBackRequest StepBack request; value of command field is 'stepBack'.
-// The request starts the debuggee to run one step backwards.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'step') after the step has completed. Clients should only call this request if the capability 'supportsStepBack' is true.
-type StepBackRequest struct {
-	// Object containing arguments for the command.
-	Arguments *StepBackArguments `json:"arguments,omitempty"`
+// StartDebuggingRequestArgumentsRequest Indicates whether the new debug session should be started with a `launch` or `attach` request.
+type StartDebuggingRequestArgumentsRequest string
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+const (
+	StartDebuggingRequestArgumentsRequestLaunch StartDebuggingRequestArgumentsRequest = "launch"
+	StartDebuggingRequestArgumentsRequestAttach StartDebuggingRequestArgumentsRequest = "attach"
+)
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// valid reports whether e is one of the values of StartDebuggingRequestArgumentsRequest.
+func (e StartDebuggingRequestArgumentsRequest) valid() bool {
+	switch e {
+	case StartDebuggingRequestArgumentsRequestLaunch, StartDebuggingRequestArgumentsRequestAttach:
+		return true
+	}
+	return false
+}
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// StartDebuggingResponse Response to `startDebugging` request. This is just an acknowledgement, so no body field is required.
+type StartDebuggingResponse struct {
+	Response
 }
 
-// StepBackResponse Response to 'stepBack' request. This is just an acknowledgement, so no body field is required.
-type StepBackResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// StepBackArguments Arguments for `stepBack` request.
+type StepBackArguments struct {
+	// Stepping granularity to step. If no granularity is specified, a granularity of `statement` is assumed.
+	Granularity SteppingGranularity `json:"granularity,omitempty"`
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+	// If this flag is true, all other suspended threads are not resumed.
+	SingleThread bool `json:"singleThread,omitempty"`
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+	// Specifies the thread for which to resume execution for one step backwards (of the given granularity).
+	ThreadId int `json:"threadId"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// StepBackRequest StepBack request; value of command field is 'stepBack'.
+// The request executes one backward step (in the given granularity) for the specified thread and allows all other threads to run backward freely by resuming them.
+// If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true prevents other suspended threads from resuming.
+// The debug adapter first sends the response and then a `stopped` event (with reason `step`) after the step has completed.
+// Clients should only call this request if the corresponding capability `supportsStepBack` is true.
+type StepBackRequest struct {
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *StepBackArguments `json:"arguments"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StepBackRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// StepBackResponse Response to `stepBack` request. This is just an acknowledgement, so no body field is required.
+type StepBackResponse struct {
+	Response
 }
 
-// StepInArguments Arguments for 'stepIn' request.
+// StepInArguments Arguments for `stepIn` request.
 type StepInArguments struct {
-	// Optional id of the target to step into.
-	TargetId float64 `json:"targetId,omitempty"`
+	// Stepping granularity. If no granularity is specified, a granularity of `statement` is assumed.
+	Granularity SteppingGranularity `json:"granularity,omitempty"`
+
+	// If this flag is true, all other suspended threads are not resumed.
+	SingleThread bool `json:"singleThread,omitempty"`
+
+	// Id of the target to step into.
+	TargetId int `json:"targetId,omitempty"`
 
-	// Execute 'stepIn' for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// Specifies the thread for which to resume execution for one step-into (of the given granularity).
+	ThreadId int `json:"threadId"`
 }
 
 // StepInRequest StepIn request; value of command field is 'stepIn'.
-// The request starts the debuggee to step into a function/method if possible.
-// If it cannot step into a target, 'stepIn' behaves like 'next'.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'step') after the step has completed.
+// The request resumes the given thread to step into a function/method and allows all other threads to run freely by resuming them.
+// If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true prevents other suspended threads from resuming.
+// If the request cannot step into a target, `stepIn` behaves like the `next` request.
+// The debug adapter first sends the response and then a `stopped` event (with reason `step`) after the step has completed.
 // If there are multiple function/method calls (or other targets) on the source line,
-// the optional argument 'targetId' can be used to control into which target the 'stepIn' should occur.
-// The list of possible targets for a given source line can be retrieved via the 'stepInTargets' request.
+// the argument `targetId` can be used to control into which target the `stepIn` should occur.
+// The list of possible targets for a given source line can be retrieved via the `stepInTargets` request.
 type StepInRequest struct {
-	// Object containing arguments for the command.
-	Arguments *StepInArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *StepInArguments `json:"arguments"`
 }
 
-// StepInResponse Response to 'stepIn' request. This is just an acknowledgement, so no body field is required.
-type StepInResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StepInRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// StepInResponse Response to `stepIn` request. This is just an acknowledgement, so no body field is required.
+type StepInResponse struct {
+	Response
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// StepInTarget A `StepInTarget` can be used in the `stepIn` request and determines into which single target the `stepIn` request should step.
+type StepInTarget struct {
+	// Start position of the range covered by the step in target. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	Column int `json:"column,omitempty"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// End position of the range covered by the step in target. It is measured in UTF-16 code units and the client capability `columnsStartAt1` determines whether it is 0- or 1-based.
+	EndColumn int `json:"endColumn,omitempty"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// The end line of the range covered by the step-in target.
+	EndLine int `json:"endLine,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+	// Unique identifier for a step-in target.
+	Id int `json:"id"`
 
-// StepInTarget A StepInTarget can be used in the 'stepIn' request and determines into which single target the stepIn request should step.
-type StepInTarget struct {
-	// Unique identifier for a stepIn target.
-	Id float64 `json:"id,omitempty"`
+	// The name of the step-in target (shown in the UI).
+	Label string `json:"label"`
 
-	// The name of the stepIn target (shown in the UI).
-	Label string `json:"label,omitempty"`
+	// The line of the step-in target.
+	Line int `json:"line,omitempty"`
 }
 
-// StepInTargetsArguments Arguments for 'stepInTargets' request.
+// StepInTargetsArguments Arguments for `stepInTargets` request.
 type StepInTargetsArguments struct {
-	// The stack frame for which to retrieve the possible stepIn targets.
-	FrameId float64 `json:"frameId,omitempty"`
+	// The stack frame for which to retrieve the possible step-in targets.
+	FrameId int `json:"frameId"`
 }
 
 // StepInTargetsRequest StepInTargets request; value of command field is 'stepInTargets'.
-// This request retrieves the possible stepIn targets for the specified stack frame.
-// These targets can be used in the 'stepIn' request.
-// The StepInTargets may only be called if the 'supportsStepInTargetsRequest' capability exists and is true.
+// This request retrieves the possible step-in targets for the specified stack frame.
+// These targets can be used in the `stepIn` request.
+// Clients should only call this request if the corresponding capability `supportsStepInTargetsRequest` is true.
 type StepInTargetsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *StepInTargetsArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *StepInTargetsArguments `json:"arguments"`
 }
 
-// StepInTargetsResponse Response to 'stepInTargets' request.
-type StepInTargetsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StepInTargetsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// StepInTargetsResponse Response to `stepInTargets` request.
+type StepInTargetsResponse struct {
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *StepInTargetsResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *StepInTargetsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// StepInTargetsResponseBody Contains request result if success is true and error details if success is false.
+type StepInTargetsResponseBody struct {
+	// The possible step-in targets of the specified source location.
+	Targets []*StepInTarget `json:"targets"`
 }
 
-// StepOutArguments Arguments for 'stepOut' request.
+// StepOutArguments Arguments for `stepOut` request.
 type StepOutArguments struct {
-	// Execute 'stepOut' for this thread.
-	ThreadId float64 `json:"threadId,omitempty"`
+	// Stepping granularity. If no granularity is specified, a granularity of `statement` is assumed.
+	Granularity SteppingGranularity `json:"granularity,omitempty"`
+
+	// If this flag is true, all other suspended threads are not resumed.
+	SingleThread bool `json:"singleThread,omitempty"`
+
+	// Specifies the thread for which to resume execution for one step-out (of the given granularity).
+	ThreadId int `json:"threadId"`
 }
 
 // StepOutRequest StepOut request; value of command field is 'stepOut'.
-// The request starts the debuggee to run again for one step.
-// The debug adapter first sends the response and then a 'stopped' event (with reason 'step') after the step has completed.
+// The request resumes the given thread to step out (return) from a function/method and allows all other threads to run freely by resuming them.
+// If the debug adapter supports single thread execution (see capability `supportsSingleThreadExecutionRequests`), setting the `singleThread` argument to true prevents other suspended threads from resuming.
+// The debug adapter first sends the response and then a `stopped` event (with reason `step`) after the step has completed.
 type StepOutRequest struct {
-	// Object containing arguments for the command.
-	Arguments *StepOutArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *StepOutArguments `json:"arguments"`
 }
 
-// StepOutResponse Response to 'stepOut' request. This is just an acknowledgement, so no body field is required.
-type StepOutResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *StepOutRequest) GetArguments() interface{} { return m.Arguments }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// StepOutResponse Response to `stepOut` request. This is just an acknowledgement, so no body field is required.
+type StepOutResponse struct {
+	Response
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// SteppingGranularity The granularity of one 'step' in the stepping requests `next`, `stepIn`, `stepOut`, and `stepBack`.
+type SteppingGranularity string
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+const (
+	// SteppingGranularityStatement The step should allow the program to run until the current statement has finished executing.
+	// The meaning of a statement is determined by the adapter and it may be considered equivalent to a line.
+	// For example 'for(int i = 0; i < 10; i++)' could be considered to have 3 statements 'int i = 0', 'i < 10', and 'i++'.
+	SteppingGranularityStatement SteppingGranularity = "statement"
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// SteppingGranularityLine The step should allow the program to run until the current source line has executed.
+	SteppingGranularityLine SteppingGranularity = "line"
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// SteppingGranularityInstruction The step should allow one instruction to execute (e.g. one x86 instruction).
+	SteppingGranularityInstruction SteppingGranularity = "instruction"
+)
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// valid reports whether e is one of the values of SteppingGranularity.
+func (e SteppingGranularity) valid() bool {
+	switch e {
+	case SteppingGranularityStatement, SteppingGranularityLine, SteppingGranularityInstruction:
+		return true
+	}
+	return false
 }
 
 // StoppedEvent Event message for 'stopped' event type.
 // The event indicates that the execution of the debuggee has stopped due to some condition.
-// This can be caused by a break point previously set, a stepping action has completed, by executing a debugger statement etc.
+// This can be caused by a breakpoint previously set, a stepping request has completed, by executing a debugger statement etc.
 type StoppedEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *StoppedEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *StoppedEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// StoppedEventBody Event-specific information.
+type StoppedEventBody struct {
+	// If `allThreadsStopped` is true, a debug adapter can announce that all threads have stopped.
+	// - The client should use this information to enable that all threads can be expanded to access their stacktraces.
+	// - If the attribute is missing or false, only the thread with the given `threadId` can be expanded.
+	AllThreadsStopped bool `json:"allThreadsStopped,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
-}
+	// The full reason for the event, e.g. 'Paused on exception'. This string is shown in the UI as is and can be translated.
+	Description string `json:"description,omitempty"`
 
-// TerminateArguments Arguments for 'terminate' request.
-type TerminateArguments struct {
-	// A value of true indicates that this 'terminate' request is part of a restart sequence.
-	Restart bool `json:"restart,omitempty"`
-}
+	// Ids of the breakpoints that triggered the event. In most cases there is only a single breakpoint but here are some examples for multiple breakpoints:
+	// - Different types of breakpoints map to the same location.
+	// - Multiple source breakpoints get collapsed to the same instruction by the compiler/runtime.
+	// - Multiple function breakpoints with different function names map to the same location.
+	HitBreakpointIds []int `json:"hitBreakpointIds,omitempty"`
 
-// TerminateRequest Terminate request; value of command field is 'terminate'.
-// The 'terminate' request is sent from the client to the debug adapter in order to give the debuggee a chance for terminating itself.
-type TerminateRequest struct {
-	// Object containing arguments for the command.
-	Arguments *TerminateArguments `json:"arguments,omitempty"`
+	// A value of true hints to the client that this event should not change the focus.
+	PreserveFocusHint bool `json:"preserveFocusHint,omitempty"`
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+	// The reason for the event.
+	// For backward compatibility this string is shown in the UI if the `description` attribute is missing (but it must not be translated).
+	// Values: 'step', 'breakpoint', 'exception', 'pause', 'entry', 'goto', 'function breakpoint', 'data breakpoint', 'instruction breakpoint', etc.
+	Reason StoppedEventReason `json:"reason"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Additional information. E.g. if reason is `exception`, text contains the exception name. This string is shown in the UI.
+	Text string `json:"text,omitempty"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The thread which was stopped.
+	ThreadId int `json:"threadId,omitempty"`
 }
 
-// TerminateResponse Response to 'terminate' request. This is just an acknowledgement, so no body field is required.
-type TerminateResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
+// StoppedEventReason The reason for the event.
+// For backward compatibility this string is shown in the UI if the `description` attribute is missing (but it must not be translated).
+type StoppedEventReason string
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+const (
+	StoppedEventReasonStep                  StoppedEventReason = "step"
+	StoppedEventReasonBreakpoint            StoppedEventReason = "breakpoint"
+	StoppedEventReasonException             StoppedEventReason = "exception"
+	StoppedEventReasonPause                 StoppedEventReason = "pause"
+	StoppedEventReasonEntry                 StoppedEventReason = "entry"
+	StoppedEventReasonGoto                  StoppedEventReason = "goto"
+	StoppedEventReasonFunctionBreakpoint    StoppedEventReason = "function breakpoint"
+	StoppedEventReasonDataBreakpoint        StoppedEventReason = "data breakpoint"
+	StoppedEventReasonInstructionBreakpoint StoppedEventReason = "instruction breakpoint"
+)
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// TerminateArguments Arguments for `terminate` request.
+type TerminateArguments struct {
+	// A value of true indicates that this `terminate` request is part of a restart sequence.
+	Restart bool `json:"restart,omitempty"`
+}
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+// TerminateRequest Terminate request; value of command field is 'terminate'.
+// The `terminate` request is sent from the client to the debug adapter in order to shut down the debuggee gracefully. Clients should only call this request if the capability `supportsTerminateRequest` is true.
+// Typically a debug adapter implements `terminate` by sending a software signal which the debuggee intercepts in order to clean things up properly before terminating itself.
+// Please note that this request does not directly affect the state of the debug session: if the debuggee decides to veto the graceful shutdown for any reason by not terminating itself, then the debug session just continues.
+// Clients can surface the `terminate` request as an explicit command or they can integrate it into a two stage Stop command that first sends `terminate` to request a graceful shutdown, and if that fails uses `disconnect` for a forceful shutdown.
+type TerminateRequest struct {
+	Request
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *TerminateArguments `json:"arguments,omitempty"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *TerminateRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// TerminateResponse Response to `terminate` request. This is just an acknowledgement, so no body field is required.
+type TerminateResponse struct {
+	Response
 }
 
-// TerminateThreadsArguments Arguments for 'terminateThreads' request.
+// TerminateThreadsArguments Arguments for `terminateThreads` request.
 type TerminateThreadsArguments struct {
 	// Ids of threads to be terminated.
-	ThreadIds []float64 `json:"threadIds,omitempty"`
+	ThreadIds []int `json:"threadIds,omitempty"`
 }
 
 // TerminateThreadsRequest TerminateThreads request; value of command field is 'terminateThreads'.
 // The request terminates the threads with the given ids.
+// Clients should only call this request if the corresponding capability `supportsTerminateThreadsRequest` is true.
 type TerminateThreadsRequest struct {
-	// Object containing arguments for the command.
-	Arguments *TerminateThreadsArguments `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	Request
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Object containing arguments for the command.
+	Arguments *TerminateThreadsArguments `json:"arguments"`
 }
 
-// TerminateThreadsResponse Response to 'terminateThreads' request. This is just an acknowledgement, so no body field is required.
-type TerminateThreadsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body interface{} `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *TerminateThreadsRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// TerminateThreadsResponse Response to `terminateThreads` request. This is just an acknowledgement, no body field is required.
+type TerminateThreadsResponse struct {
+	Response
 }
 
 // TerminatedEvent Event message for 'terminated' event type.
 // The event indicates that debugging of the debuggee has terminated. This does **not** mean that the debuggee itself has exited.
 type TerminatedEvent struct {
-	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Event
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+	// Event-specific information.
+	Body *TerminatedEventBody `json:"body,omitempty"`
+}
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// GetBody implements EventMessage.
+func (m *TerminatedEvent) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// TerminatedEventBody Event-specific information.
+type TerminatedEventBody struct {
+	// A debug adapter may set `restart` to true (or to an arbitrary object) to request that the client restarts the session.
+	// The value is not interpreted by the client and passed unmodified as an attribute `__restart` to the `launch` and `attach` requests.
+	Restart interface{} `json:"restart,omitempty"`
 }
 
 // Thread A Thread
 type Thread struct {
 	// Unique identifier for the thread.
-	Id float64 `json:"id,omitempty"`
+	Id int `json:"id"`
 
-	// A name of the thread.
-	Name string `json:"name,omitempty"`
+	// The name of the thread.
+	Name string `json:"name"`
 }
 
 // ThreadEvent Event message for 'thread' event type.
 // The event indicates that a thread has started or exited.
 type ThreadEvent struct {
+	Event
+
 	// Event-specific information.
-	Body *Body `json:"body,omitempty"`
+	Body *ThreadEventBody `json:"body"`
+}
 
-	// Type of event.
-	Event string `json:"event,omitempty"`
+// GetBody implements EventMessage.
+func (m *ThreadEvent) GetBody() interface{} { return m.Body }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// ThreadEventBody Event-specific information.
+type ThreadEventBody struct {
+	// The reason for the event.
+	// Values: 'started', 'exited', etc.
+	Reason ThreadEventReason `json:"reason"`
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// The identifier of the thread.
+	ThreadId int `json:"threadId"`
 }
 
+// ThreadEventReason The reason for the event.
+type ThreadEventReason string
+
+const (
+	ThreadEventReasonStarted ThreadEventReason = "started"
+	ThreadEventReasonExited  ThreadEventReason = "exited"
+)
+
 // ThreadsRequest Threads request; value of command field is 'threads'.
 // The request retrieves a list of all threads.
 type ThreadsRequest struct {
-	// Object containing arguments for the command.
-	Arguments interface{} `json:"arguments,omitempty"`
-
-	// The command to execute.
-	Command string `json:"command,omitempty"`
-
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
-
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	Request
 }
 
-// ThreadsResponse Response to 'threads' request.
+// ThreadsResponse Response to `threads` request.
 type ThreadsResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
-
-	// The command requested.
-	Command string `json:"command,omitempty"`
-
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
-
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	Response
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *ThreadsResponseBody `json:"body"`
+}
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *ThreadsResponse) GetBody() interface{} { return m.Body }
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// ThreadsResponseBody Contains request result if success is true and error details if success is false.
+type ThreadsResponseBody struct {
+	// All threads.
+	Threads []*Thread `json:"threads"`
 }
 
 // ValueFormat Provides formatting information for a value.
@@ -3308,43 +3539,60 @@ type ValueFormat struct {
 }
 
 // Variable A Variable is a name/value pair.
-// Optionally a variable can have a 'type' that is shown if space permits or when hovering over the variable's name.
-// An optional 'kind' is used to render additional properties of the variable, e.g. different icons can be used to indicate that a variable is public or private.
-// If the value is structured (has children), a handle is provided to retrieve the children with the VariablesRequest.
-// If the number of named or indexed children is large, the numbers should be returned via the optional 'namedVariables' and 'indexedVariables' attributes.
-// The client can use this optional information to present the children in a paged UI and fetch them in chunks.
+// The `type` attribute is shown if space permits or when hovering over the variable's name.
+// The `kind` attribute is used to render additional properties of the variable, e.g. different icons can be used to indicate that a variable is public or private.
+// If the value is structured (has children), a handle is provided to retrieve the children with the `variables` request.
+// If the number of named or indexed children is large, the numbers should be returned via the `namedVariables` and `indexedVariables` attributes.
+// The client can use this information to present the children in a paged UI and fetch them in chunks.
 type Variable struct {
-	// Optional evaluatable name of this variable which can be passed to the 'EvaluateRequest' to fetch the variable's value.
+	// A reference that allows the client to request the location where the variable is declared. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	DeclarationLocationReference int `json:"declarationLocationReference,omitempty"`
+
+	// The evaluatable name of this variable which can be passed to the `evaluate` request to fetch the variable's value.
 	EvaluateName string `json:"evaluateName,omitempty"`
 
 	// The number of indexed child variables.
-	// The client can use this optional information to present the children in a paged UI and fetch them in chunks.
-	IndexedVariables float64 `json:"indexedVariables,omitempty"`
+	// The client can use this information to present the children in a paged UI and fetch them in chunks.
+	IndexedVariables int `json:"indexedVariables,omitempty"`
 
-	// Optional memory reference for the variable if the variable represents executable code, such as a function pointer.
+	// A memory reference associated with this variable.
+	// For pointer type variables, this is generally a reference to the memory address contained in the pointer.
+	// For executable data, this reference may later be used in a `disassemble` request.
+	// This attribute may be returned by a debug adapter if corresponding capability `supportsMemoryReferences` is true.
 	MemoryReference string `json:"memoryReference,omitempty"`
 
 	// The variable's name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 
 	// The number of named child variables.
-	// The client can use this optional information to present the children in a paged UI and fetch them in chunks.
-	NamedVariables float64 `json:"namedVariables,omitempty"`
+	// The client can use this information to present the children in a paged UI and fetch them in chunks.
+	NamedVariables int `json:"namedVariables,omitempty"`
 
 	// Properties of a variable that can be used to determine how to render the variable in the UI.
 	PresentationHint *VariablePresentationHint `json:"presentationHint,omitempty"`
 
 	// The type of the variable's value. Typically shown in the UI when hovering over the value.
+	// This attribute should only be returned by a debug adapter if the corresponding capability `supportsVariableType` is true.
 	Type string `json:"type,omitempty"`
 
-	// The variable's value. This can be a multi-line text, e.g. for a function the body of a function.
-	Value string `json:"value,omitempty"`
+	// The variable's value.
+	// This can be a multi-line text, e.g. for a function the body of a function.
+	// For structured variables (which do not have a simple value), it is recommended to provide a one-line representation of the structured object. This helps to identify the structured object in the collapsed state when its children are not yet visible.
+	// An empty string can be used if no value should be shown in the UI.
+	Value string `json:"value"`
 
-	// If variablesReference is > 0, the variable is structured and its children can be retrieved by passing variablesReference to the VariablesRequest.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+	// A reference that allows the client to request the location where the variable's value is declared. For example, if the variable contains a function pointer, the adapter may be able to look up the function's location. This should be present only if the adapter is likely to be able to resolve the location.
+	//
+	// This reference shares the same lifetime as the `variablesReference`. See 'Lifetime of Object References' in the Overview section for details.
+	ValueLocationReference int `json:"valueLocationReference,omitempty"`
+
+	// If `variablesReference` is > 0, the variable is structured and its children can be retrieved by passing `variablesReference` to the `variables` request as long as execution remains suspended. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference"`
 }
 
-// VariablePresentationHint Optional properties of a variable that can be used to determine how to render the variable in the UI.
+// VariablePresentationHint Properties of a variable that can be used to determine how to render the variable in the UI.
 type VariablePresentationHint struct {
 	// Set of attributes represented as an array of strings. Before introducing additional values, try to use the listed values.
 	// Values:
@@ -3352,11 +3600,12 @@ type VariablePresentationHint struct {
 	// 'constant': Indicates that the object is a constant.
 	// 'readOnly': Indicates that the object is read only.
 	// 'rawString': Indicates that the object is a raw string.
-	// 'hasObjectId': Indicates that the object can have an Object ID created for it.
-	// 'canHaveObjectId': Indicates that the object has an Object ID associated with it.
+	// 'hasObjectId': Indicates that the object can have an Object ID created for it. This is a vestigial attribute that is used by some clients; 'Object ID's are not specified in the protocol.
+	// 'canHaveObjectId': Indicates that the object has an Object ID associated with it. This is a vestigial attribute that is used by some clients; 'Object ID's are not specified in the protocol.
 	// 'hasSideEffects': Indicates that the evaluation had side effects.
+	// 'hasDataBreakpoint': Indicates that the object has its value tracked by a data breakpoint.
 	// etc.
-	Attributes []string `json:"attributes,omitempty"`
+	Attributes []VariablePresentationHintAttribute `json:"attributes,omitempty"`
 
 	// The kind of variable. Before introducing additional values, try to use the listed values.
 	// Values:
@@ -3370,79 +3619,330 @@ type VariablePresentationHint struct {
 	// 'interface': Indicates that the object is an interface.
 	// 'mostDerivedClass': Indicates that the object is the most derived class.
 	// 'virtual': Indicates that the object is virtual, that means it is a synthetic object introduced by the adapter for rendering purposes, e.g. an index range for large arrays.
-	// 'dataBreakpoint': Indicates that a data breakpoint is registered for the object.
+	// 'dataBreakpoint': Deprecated: Indicates that a data breakpoint is registered for the object. The `hasDataBreakpoint` attribute should generally be used instead.
 	// etc.
-	Kind string `json:"kind,omitempty"`
+	Kind VariablePresentationHintKind `json:"kind,omitempty"`
+
+	// If true, clients can present the variable with a UI that supports a specific gesture to trigger its evaluation.
+	// This mechanism can be used for properties that require executing code when retrieving their value and where the code execution can be expensive and/or produce side-effects. A typical example are properties based on a getter function.
+	// Please note that in addition to the `lazy` flag, the variable's `variablesReference` is expected to refer to a variable that will provide the value through another `variable` request.
+	Lazy bool `json:"lazy,omitempty"`
 
 	// Visibility of variable. Before introducing additional values, try to use the listed values.
 	// Values: 'public', 'private', 'protected', 'internal', 'final', etc.
-	Visibility string `json:"visibility,omitempty"`
+	Visibility VariablePresentationHintVisibility `json:"visibility,omitempty"`
 }
 
-// VariablesArguments Arguments for 'variables' request.
+// VariablePresentationHintAttribute
+type VariablePresentationHintAttribute string
+
+const (
+	// VariablePresentationHintAttributeStatic Indicates that the object is static.
+	VariablePresentationHintAttributeStatic VariablePresentationHintAttribute = "static"
+
+	// VariablePresentationHintAttributeConstant Indicates that the object is a constant.
+	VariablePresentationHintAttributeConstant VariablePresentationHintAttribute = "constant"
+
+	// VariablePresentationHintAttributeReadOnly Indicates that the object is read only.
+	VariablePresentationHintAttributeReadOnly VariablePresentationHintAttribute = "readOnly"
+
+	// VariablePresentationHintAttributeRawString Indicates that the object is a raw string.
+	VariablePresentationHintAttributeRawString VariablePresentationHintAttribute = "rawString"
+
+	// VariablePresentationHintAttributeHasObjectId Indicates that the object can have an Object ID created for it. This is a vestigial attribute that is used by some clients; 'Object ID's are not specified in the protocol.
+	VariablePresentationHintAttributeHasObjectId VariablePresentationHintAttribute = "hasObjectId"
+
+	// VariablePresentationHintAttributeCanHaveObjectId Indicates that the object has an Object ID associated with it. This is a vestigial attribute that is used by some clients; 'Object ID's are not specified in the protocol.
+	VariablePresentationHintAttributeCanHaveObjectId VariablePresentationHintAttribute = "canHaveObjectId"
+
+	// VariablePresentationHintAttributeHasSideEffects Indicates that the evaluation had side effects.
+	VariablePresentationHintAttributeHasSideEffects VariablePresentationHintAttribute = "hasSideEffects"
+
+	// VariablePresentationHintAttributeHasDataBreakpoint Indicates that the object has its value tracked by a data breakpoint.
+	VariablePresentationHintAttributeHasDataBreakpoint VariablePresentationHintAttribute = "hasDataBreakpoint"
+)
+
+// VariablePresentationHintKind The kind of variable. Before introducing additional values, try to use the listed values.
+type VariablePresentationHintKind string
+
+const (
+	// VariablePresentationHintKindProperty Indicates that the object is a property.
+	VariablePresentationHintKindProperty VariablePresentationHintKind = "property"
+
+	// VariablePresentationHintKindMethod Indicates that the object is a method.
+	VariablePresentationHintKindMethod VariablePresentationHintKind = "method"
+
+	// VariablePresentationHintKindClass Indicates that the object is a class.
+	VariablePresentationHintKindClass VariablePresentationHintKind = "class"
+
+	// VariablePresentationHintKindData Indicates that the object is data.
+	VariablePresentationHintKindData VariablePresentationHintKind = "data"
+
+	// VariablePresentationHintKindEvent Indicates that the object is an event.
+	VariablePresentationHintKindEvent VariablePresentationHintKind = "event"
+
+	// VariablePresentationHintKindBaseClass Indicates that the object is a base class.
+	VariablePresentationHintKindBaseClass VariablePresentationHintKind = "baseClass"
+
+	// VariablePresentationHintKindInnerClass Indicates that the object is an inner class.
+	VariablePresentationHintKindInnerClass VariablePresentationHintKind = "innerClass"
+
+	// VariablePresentationHintKindInterface Indicates that the object is an interface.
+	VariablePresentationHintKindInterface VariablePresentationHintKind = "interface"
+
+	// VariablePresentationHintKindMostDerivedClass Indicates that the object is the most derived class.
+	VariablePresentationHintKindMostDerivedClass VariablePresentationHintKind = "mostDerivedClass"
+
+	// VariablePresentationHintKindVirtual Indicates that the object is virtual, that means it is a synthetic object introduced by the adapter for rendering purposes, e.g. an index range for large arrays.
+	VariablePresentationHintKindVirtual VariablePresentationHintKind = "virtual"
+
+	// VariablePresentationHintKindDataBreakpoint Deprecated: Indicates that a data breakpoint is registered for the object. The `hasDataBreakpoint` attribute should generally be used instead.
+	VariablePresentationHintKindDataBreakpoint VariablePresentationHintKind = "dataBreakpoint"
+)
+
+// VariablePresentationHintVisibility Visibility of variable. Before introducing additional values, try to use the listed values.
+type VariablePresentationHintVisibility string
+
+const (
+	VariablePresentationHintVisibilityPublic    VariablePresentationHintVisibility = "public"
+	VariablePresentationHintVisibilityPrivate   VariablePresentationHintVisibility = "private"
+	VariablePresentationHintVisibilityProtected VariablePresentationHintVisibility = "protected"
+	VariablePresentationHintVisibilityInternal  VariablePresentationHintVisibility = "internal"
+	VariablePresentationHintVisibilityFinal     VariablePresentationHintVisibility = "final"
+)
+
+// VariablesArguments Arguments for `variables` request.
 type VariablesArguments struct {
 	// The number of variables to return. If count is missing or 0, all variables are returned.
-	Count float64 `json:"count,omitempty"`
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsVariablePaging` is true.
+	Count int `json:"count,omitempty"`
 
-	// Optional filter to limit the child variables to either named or indexed. If omitted, both types are fetched.
-	Filter string `json:"filter,omitempty"`
+	// Filter to limit the child variables to either named or indexed. If omitted, both types are fetched.
+	Filter VariablesArgumentsFilter `json:"filter,omitempty"`
 
 	// Specifies details on how to format the Variable values.
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsValueFormattingOptions` is true.
 	Format *ValueFormat `json:"format,omitempty"`
 
 	// The index of the first variable to return; if omitted children start at 0.
-	Start float64 `json:"start,omitempty"`
+	// The attribute is only honored by a debug adapter if the corresponding capability `supportsVariablePaging` is true.
+	Start int `json:"start,omitempty"`
+
+	// The variable for which to retrieve its children. The `variablesReference` must have been obtained in the current suspended state. See 'Lifetime of Object References' in the Overview section for details.
+	VariablesReference int `json:"variablesReference"`
+}
 
-	// The Variable reference.
-	VariablesReference float64 `json:"variablesReference,omitempty"`
+// VariablesArgumentsFilter Filter to limit the child variables to either named or indexed. If omitted, both types are fetched.
+type VariablesArgumentsFilter string
+
+const (
+	VariablesArgumentsFilterIndexed VariablesArgumentsFilter = "indexed"
+	VariablesArgumentsFilterNamed   VariablesArgumentsFilter = "named"
+)
+
+// valid reports whether e is one of the values of VariablesArgumentsFilter.
+func (e VariablesArgumentsFilter) valid() bool {
+	switch e {
+	case VariablesArgumentsFilterIndexed, VariablesArgumentsFilterNamed:
+		return true
+	}
+	return false
 }
 
 // VariablesRequest Variables request; value of command field is 'variables'.
 // Retrieves all child variables for the given variable reference.
-// An optional filter can be used to limit the fetched children to either named or indexed children.
+// A filter can be used to limit the fetched children to either named or indexed children.
 type VariablesRequest struct {
+	Request
+
 	// Object containing arguments for the command.
-	Arguments *VariablesArguments `json:"arguments,omitempty"`
+	Arguments *VariablesArguments `json:"arguments"`
+}
 
-	// The command to execute.
-	Command string `json:"command,omitempty"`
+// GetArguments implements RequestMessage.
+func (m *VariablesRequest) GetArguments() interface{} { return m.Arguments }
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+// VariablesResponse Response to `variables` request.
+type VariablesResponse struct {
+	Response
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+	// Contains request result if success is true and error details if success is false.
+	Body *VariablesResponseBody `json:"body"`
 }
 
-// VariablesResponse Response to 'variables' request.
-type VariablesResponse struct {
-	// Contains request result if success is true and optional error details if success is false.
-	Body *Body `json:"body,omitempty"`
+// GetBody implements ResponseMessage.
+func (m *VariablesResponse) GetBody() interface{} { return m.Body }
 
-	// The command requested.
-	Command string `json:"command,omitempty"`
+// VariablesResponseBody Contains request result if success is true and error details if success is false.
+type VariablesResponseBody struct {
+	// All (or a range) of variables for the given variable reference.
+	Variables []*Variable `json:"variables"`
+}
 
-	// Contains the raw error in short form if 'success' is false.
-	// This raw error might be interpreted by the frontend and is not shown in the UI.
-	// Some predefined values exist.
-	// Values:
-	// 'cancelled': request was cancelled.
-	// etc.
-	Message string `json:"message,omitempty"`
+// WriteMemoryArguments Arguments for `writeMemory` request.
+type WriteMemoryArguments struct {
+	// Property to control partial writes. If true, the debug adapter should attempt to write memory even if the entire memory region is not writable. In such a case the debug adapter should stop after hitting the first byte of memory that cannot be written and return the number of bytes written in the response via the `offset` and `bytesWritten` properties.
+	// If false or missing, a debug adapter should attempt to verify the region is writable before writing, and fail the response if it is not.
+	AllowPartial bool `json:"allowPartial,omitempty"`
 
-	// Sequence number of the corresponding request.
-	RequestSeq float64 `json:"request_seq,omitempty"`
+	// Bytes to write, encoded using base64.
+	Data string `json:"data"`
 
-	// Sequence number (also known as message ID). For protocol messages of type 'request' this ID can be used to cancel the request.
-	Seq float64 `json:"seq,omitempty"`
+	// Memory reference to the base location to which data should be written.
+	MemoryReference string `json:"memoryReference"`
 
-	// Outcome of the request.
-	// If true, the request was successful and the 'body' attribute may contain the result of the request.
-	// If the value is false, the attribute 'message' contains the error in short form and the 'body' may contain additional information (see 'ErrorResponse.body.error').
-	Success bool `json:"success,omitempty"`
+	// Offset (in bytes) to be applied to the reference location before writing data. Can be negative.
+	Offset int `json:"offset,omitempty"`
+}
 
-	// Message type.
-	// Values: 'request', 'response', 'event', etc.
-	Type string `json:"type,omitempty"`
+// WriteMemoryRequest WriteMemory request; value of command field is 'writeMemory'.
+// Writes bytes to memory at the provided location.
+// Clients should only call this request if the corresponding capability `supportsWriteMemoryRequest` is true.
+type WriteMemoryRequest struct {
+	Request
+
+	// Object containing arguments for the command.
+	Arguments *WriteMemoryArguments `json:"arguments"`
+}
+
+// GetArguments implements RequestMessage.
+func (m *WriteMemoryRequest) GetArguments() interface{} { return m.Arguments }
+
+// WriteMemoryResponse Response to `writeMemory` request.
+type WriteMemoryResponse struct {
+	Response
+
+	// Contains request result if success is true and error details if success is false.
+	Body *WriteMemoryResponseBody `json:"body,omitempty"`
+}
+
+// GetBody implements ResponseMessage.
+func (m *WriteMemoryResponse) GetBody() interface{} { return m.Body }
+
+// WriteMemoryResponseBody Contains request result if success is true and error details if success is false.
+type WriteMemoryResponseBody struct {
+	// Property that should be returned when `allowPartial` is true to indicate the number of bytes starting from address that were successfully written.
+	BytesWritten int `json:"bytesWritten,omitempty"`
+
+	// Property that should be returned when `allowPartial` is true to indicate the offset of the first byte of data successfully written. Can be negative.
+	Offset int `json:"offset,omitempty"`
+}
+
+// requestTypes maps the command of a request to the constructor of the request.
+var requestTypes = map[string]func() RequestMessage{
+	"attach":                    func() RequestMessage { return new(AttachRequest) },
+	"breakpointLocations":       func() RequestMessage { return new(BreakpointLocationsRequest) },
+	"cancel":                    func() RequestMessage { return new(CancelRequest) },
+	"completions":               func() RequestMessage { return new(CompletionsRequest) },
+	"configurationDone":         func() RequestMessage { return new(ConfigurationDoneRequest) },
+	"continue":                  func() RequestMessage { return new(ContinueRequest) },
+	"dataBreakpointInfo":        func() RequestMessage { return new(DataBreakpointInfoRequest) },
+	"disassemble":               func() RequestMessage { return new(DisassembleRequest) },
+	"disconnect":                func() RequestMessage { return new(DisconnectRequest) },
+	"evaluate":                  func() RequestMessage { return new(EvaluateRequest) },
+	"exceptionInfo":             func() RequestMessage { return new(ExceptionInfoRequest) },
+	"goto":                      func() RequestMessage { return new(GotoRequest) },
+	"gotoTargets":               func() RequestMessage { return new(GotoTargetsRequest) },
+	"initialize":                func() RequestMessage { return new(InitializeRequest) },
+	"launch":                    func() RequestMessage { return new(LaunchRequest) },
+	"loadedSources":             func() RequestMessage { return new(LoadedSourcesRequest) },
+	"locations":                 func() RequestMessage { return new(LocationsRequest) },
+	"modules":                   func() RequestMessage { return new(ModulesRequest) },
+	"next":                      func() RequestMessage { return new(NextRequest) },
+	"pause":                     func() RequestMessage { return new(PauseRequest) },
+	"readMemory":                func() RequestMessage { return new(ReadMemoryRequest) },
+	"restart":                   func() RequestMessage { return new(RestartRequest) },
+	"restartFrame":              func() RequestMessage { return new(RestartFrameRequest) },
+	"reverseContinue":           func() RequestMessage { return new(ReverseContinueRequest) },
+	"runInTerminal":             func() RequestMessage { return new(RunInTerminalRequest) },
+	"scopes":                    func() RequestMessage { return new(ScopesRequest) },
+	"setBreakpoints":            func() RequestMessage { return new(SetBreakpointsRequest) },
+	"setDataBreakpoints":        func() RequestMessage { return new(SetDataBreakpointsRequest) },
+	"setExceptionBreakpoints":   func() RequestMessage { return new(SetExceptionBreakpointsRequest) },
+	"setExpression":             func() RequestMessage { return new(SetExpressionRequest) },
+	"setFunctionBreakpoints":    func() RequestMessage { return new(SetFunctionBreakpointsRequest) },
+	"setInstructionBreakpoints": func() RequestMessage { return new(SetInstructionBreakpointsRequest) },
+	"setVariable":               func() RequestMessage { return new(SetVariableRequest) },
+	"source":                    func() RequestMessage { return new(SourceRequest) },
+	"stackTrace":                func() RequestMessage { return new(StackTraceRequest) },
+	"startDebugging":            func() RequestMessage { return new(StartDebuggingRequest) },
+	"stepBack":                  func() RequestMessage { return new(StepBackRequest) },
+	"stepIn":                    func() RequestMessage { return new(StepInRequest) },
+	"stepInTargets":             func() RequestMessage { return new(StepInTargetsRequest) },
+	"stepOut":                   func() RequestMessage { return new(StepOutRequest) },
+	"terminate":                 func() RequestMessage { return new(TerminateRequest) },
+	"terminateThreads":          func() RequestMessage { return new(TerminateThreadsRequest) },
+	"threads":                   func() RequestMessage { return new(ThreadsRequest) },
+	"variables":                 func() RequestMessage { return new(VariablesRequest) },
+	"writeMemory":               func() RequestMessage { return new(WriteMemoryRequest) },
+}
+
+// responseTypes maps the command of a request to the constructor of its response.
+var responseTypes = map[string]func() ResponseMessage{
+	"attach":                    func() ResponseMessage { return new(AttachResponse) },
+	"breakpointLocations":       func() ResponseMessage { return new(BreakpointLocationsResponse) },
+	"cancel":                    func() ResponseMessage { return new(CancelResponse) },
+	"completions":               func() ResponseMessage { return new(CompletionsResponse) },
+	"configurationDone":         func() ResponseMessage { return new(ConfigurationDoneResponse) },
+	"continue":                  func() ResponseMessage { return new(ContinueResponse) },
+	"dataBreakpointInfo":        func() ResponseMessage { return new(DataBreakpointInfoResponse) },
+	"disassemble":               func() ResponseMessage { return new(DisassembleResponse) },
+	"disconnect":                func() ResponseMessage { return new(DisconnectResponse) },
+	"evaluate":                  func() ResponseMessage { return new(EvaluateResponse) },
+	"exceptionInfo":             func() ResponseMessage { return new(ExceptionInfoResponse) },
+	"goto":                      func() ResponseMessage { return new(GotoResponse) },
+	"gotoTargets":               func() ResponseMessage { return new(GotoTargetsResponse) },
+	"initialize":                func() ResponseMessage { return new(InitializeResponse) },
+	"launch":                    func() ResponseMessage { return new(LaunchResponse) },
+	"loadedSources":             func() ResponseMessage { return new(LoadedSourcesResponse) },
+	"locations":                 func() ResponseMessage { return new(LocationsResponse) },
+	"modules":                   func() ResponseMessage { return new(ModulesResponse) },
+	"next":                      func() ResponseMessage { return new(NextResponse) },
+	"pause":                     func() ResponseMessage { return new(PauseResponse) },
+	"readMemory":                func() ResponseMessage { return new(ReadMemoryResponse) },
+	"restart":                   func() ResponseMessage { return new(RestartResponse) },
+	"restartFrame":              func() ResponseMessage { return new(RestartFrameResponse) },
+	"reverseContinue":           func() ResponseMessage { return new(ReverseContinueResponse) },
+	"runInTerminal":             func() ResponseMessage { return new(RunInTerminalResponse) },
+	"scopes":                    func() ResponseMessage { return new(ScopesResponse) },
+	"setBreakpoints":            func() ResponseMessage { return new(SetBreakpointsResponse) },
+	"setDataBreakpoints":        func() ResponseMessage { return new(SetDataBreakpointsResponse) },
+	"setExceptionBreakpoints":   func() ResponseMessage { return new(SetExceptionBreakpointsResponse) },
+	"setExpression":             func() ResponseMessage { return new(SetExpressionResponse) },
+	"setFunctionBreakpoints":    func() ResponseMessage { return new(SetFunctionBreakpointsResponse) },
+	"setInstructionBreakpoints": func() ResponseMessage { return new(SetInstructionBreakpointsResponse) },
+	"setVariable":               func() ResponseMessage { return new(SetVariableResponse) },
+	"source":                    func() ResponseMessage { return new(SourceResponse) },
+	"stackTrace":                func() ResponseMessage { return new(StackTraceResponse) },
+	"startDebugging":            func() ResponseMessage { return new(StartDebuggingResponse) },
+	"stepBack":                  func() ResponseMessage { return new(StepBackResponse) },
+	"stepIn":                    func() ResponseMessage { return new(StepInResponse) },
+	"stepInTargets":             func() ResponseMessage { return new(StepInTargetsResponse) },
+	"stepOut":                   func() ResponseMessage { return new(StepOutResponse) },
+	"terminate":                 func() ResponseMessage { return new(TerminateResponse) },
+	"terminateThreads":          func() ResponseMessage { return new(TerminateThreadsResponse) },
+	"threads":                   func() ResponseMessage { return new(ThreadsResponse) },
+	"variables":                 func() ResponseMessage { return new(VariablesResponse) },
+	"writeMemory":               func() ResponseMessage { return new(WriteMemoryResponse) },
+}
+
+// eventTypes maps the event type to the constructor of the event.
+var eventTypes = map[string]func() EventMessage{
+	"breakpoint":     func() EventMessage { return new(BreakpointEvent) },
+	"capabilities":   func() EventMessage { return new(CapabilitiesEvent) },
+	"continued":      func() EventMessage { return new(ContinuedEvent) },
+	"exited":         func() EventMessage { return new(ExitedEvent) },
+	"initialized":    func() EventMessage { return new(InitializedEvent) },
+	"invalidated":    func() EventMessage { return new(InvalidatedEvent) },
+	"loadedSource":   func() EventMessage { return new(LoadedSourceEvent) },
+	"memory":         func() EventMessage { return new(MemoryEvent) },
+	"module":         func() EventMessage { return new(ModuleEvent) },
+	"output":         func() EventMessage { return new(OutputEvent) },
+	"process":        func() EventMessage { return new(ProcessEvent) },
+	"progressEnd":    func() EventMessage { return new(ProgressEndEvent) },
+	"progressStart":  func() EventMessage { return new(ProgressStartEvent) },
+	"progressUpdate": func() EventMessage { return new(ProgressUpdateEvent) },
+	"stopped":        func() EventMessage { return new(StoppedEvent) },
+	"terminated":     func() EventMessage { return new(TerminatedEvent) },
+	"thread":         func() EventMessage { return new(ThreadEvent) },
 }
@@ -0,0 +1,221 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// assertJSONEqual fails t if got and want are not the same JSON value.
+func assertJSONEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	if err := json.Unmarshal(want, &w); err != nil {
+		t.Fatalf("invalid JSON %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("got JSON\n\t%s\nwant\n\t%s", got, want)
+	}
+}
+
+func TestEventRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		event EventMessage
+		data  string
+	}{
+		{
+			name:  "stopped",
+			event: new(StoppedEvent),
+			data: `{"seq":12,"type":"event","event":"stopped","body":{"reason":"breakpoint","description":"Paused on breakpoint",` +
+				`"threadId":1,"allThreadsStopped":true}}`,
+		},
+		{
+			name:  "output",
+			event: new(OutputEvent),
+			data: `{"seq":7,"type":"event","event":"output","body":{"category":"stdout","output":"hello, world\n",` +
+				`"source":{"name":"main.go","path":"/src/main.go"},"line":9,"column":2}}`,
+		},
+		{
+			name:  "exited",
+			event: new(ExitedEvent),
+			data:  `{"seq":40,"type":"event","event":"exited","body":{"exitCode":0}}`,
+		},
+		{
+			name:  "breakpoint",
+			event: new(BreakpointEvent),
+			data: `{"seq":5,"type":"event","event":"breakpoint","body":{"reason":"changed",` +
+				`"breakpoint":{"id":3,"verified":true,"source":{"path":"/src/main.go"},"line":12}}}`,
+		},
+		{
+			name:  "thread",
+			event: new(ThreadEvent),
+			data:  `{"seq":3,"type":"event","event":"thread","body":{"reason":"started","threadId":18}}`,
+		},
+		{
+			name:  "module",
+			event: new(ModuleEvent),
+			data: `{"seq":4,"type":"event","event":"module","body":{"reason":"new",` +
+				`"module":{"id":1,"name":"libc.so.6","path":"/usr/lib/libc.so.6","isOptimized":true,"symbolStatus":"Symbols loaded."}}}`,
+		},
+		{
+			name:  "process",
+			event: new(ProcessEvent),
+			data: `{"seq":2,"type":"event","event":"process","body":{"name":"/src/main","systemProcessId":4242,` +
+				`"isLocalProcess":true,"startMethod":"launch","pointerSize":64}}`,
+		},
+		{
+			name:  "loadedSource",
+			event: new(LoadedSourceEvent),
+			data: `{"seq":6,"type":"event","event":"loadedSource","body":{"reason":"new",` +
+				`"source":{"name":"util.js","path":"/src/util.js","origin":"eval"}}}`,
+		},
+		{
+			name:  "capabilities",
+			event: new(CapabilitiesEvent),
+			data: `{"seq":8,"type":"event","event":"capabilities","body":{"capabilities":` +
+				`{"supportsConfigurationDoneRequest":true,"supportsStepBack":true}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.data), tt.event); err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.event.GetEvent(); got != tt.name {
+				t.Errorf("event = %q, want %q", got, tt.name)
+			}
+			if reflect.ValueOf(tt.event.GetBody()).IsNil() {
+				t.Fatal("body not decoded")
+			}
+			data, err := json.Marshal(tt.event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertJSONEqual(t, data, []byte(tt.data))
+
+			msg, err := DecodeMessage([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reflect.TypeOf(msg) != reflect.TypeOf(tt.event) {
+				t.Errorf("DecodeMessage returned %T, want %T", msg, tt.event)
+			}
+		})
+	}
+}
+
+// largeValues are the reference and thread ids beyond the range of int32 and float32,
+// up to the integers float64 represents exactly.
+var largeValues = []int{1<<31 - 2, 1<<31 - 1, 1 << 31, 1<<53 - 1, 1 << 53}
+
+func TestLargeReferenceRoundTrip(t *testing.T) {
+	for _, v := range largeValues {
+		variable := &Variable{Name: "x", Value: "1", VariablesReference: v}
+		source := &Source{Name: "eval", SourceReference: v}
+		stopped := &StoppedEventBody{Reason: StoppedEventReasonStep, ThreadId: v}
+
+		data, err := json.Marshal(variable)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var gotVariable Variable
+		if err := json.Unmarshal(data, &gotVariable); err != nil {
+			t.Fatal(err)
+		}
+		if gotVariable.VariablesReference != v {
+			t.Errorf("variablesReference: got %d from %s, want %d", gotVariable.VariablesReference, data, v)
+		}
+
+		if data, err = json.Marshal(source); err != nil {
+			t.Fatal(err)
+		}
+		var gotSource Source
+		if err := json.Unmarshal(data, &gotSource); err != nil {
+			t.Fatal(err)
+		}
+		if gotSource.SourceReference != v {
+			t.Errorf("sourceReference: got %d from %s, want %d", gotSource.SourceReference, data, v)
+		}
+
+		if data, err = json.Marshal(stopped); err != nil {
+			t.Fatal(err)
+		}
+		var gotStopped StoppedEventBody
+		if err := json.Unmarshal(data, &gotStopped); err != nil {
+			t.Fatal(err)
+		}
+		if gotStopped.ThreadId != v {
+			t.Errorf("threadId: got %d from %s, want %d", gotStopped.ThreadId, data, v)
+		}
+	}
+}
+
+func TestRequiredPropertiesWireJSON(t *testing.T) {
+	no := false
+	yes := true
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "Response.Success=false",
+			v:    &Response{ProtocolMessage: ProtocolMessage{Seq: 2, Type: ProtocolMessageTypeResponse}, Command: "next", RequestSeq: 1},
+			want: `{"seq":2,"type":"response","command":"next","request_seq":1,"success":false}`,
+		},
+		{
+			name: "Breakpoint.Verified=false",
+			v:    &Breakpoint{},
+			want: `{"verified":false}`,
+		},
+		{
+			name: "StackFrame.Line=0",
+			v:    &StackFrame{Id: 1, Name: "main"},
+			want: `{"id":1,"name":"main","line":0,"column":0}`,
+		},
+		{
+			name: "InitializeRequestArguments.LinesStartAt1=nil",
+			v:    &InitializeRequestArguments{AdapterID: "go"},
+			want: `{"adapterID":"go"}`,
+		},
+		{
+			name: "InitializeRequestArguments.LinesStartAt1=false",
+			v:    &InitializeRequestArguments{AdapterID: "go", LinesStartAt1: &no},
+			want: `{"adapterID":"go","linesStartAt1":false}`,
+		},
+		{
+			name: "InitializeRequestArguments.LinesStartAt1=true",
+			v:    &InitializeRequestArguments{AdapterID: "go", LinesStartAt1: &yes},
+			want: `{"adapterID":"go","linesStartAt1":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertJSONEqual(t, data, []byte(tt.want))
+
+			got := reflect.New(reflect.TypeOf(tt.v).Elem()).Interface()
+			if err := json.Unmarshal([]byte(tt.want), got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.v) {
+				t.Errorf("decoded %s into %+v, want %+v", tt.want, got, tt.v)
+			}
+		})
+	}
+}
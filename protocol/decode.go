@@ -0,0 +1,149 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// DecodeMessage decodes data into the concrete type of the protocol message,
+// such as *SetBreakpointsRequest, *StackTraceResponse or *StoppedEvent.
+//
+// A request with an unknown command is decoded into *Request and an event with an unknown type is decoded into *Event,
+// both carrying the raw arguments or body. Responses are decoded as DecodeResponse does.
+func DecodeMessage(data []byte) (Message, error) {
+	var m ProtocolMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("decode message: %w", err)
+	}
+
+	switch m.Type {
+	case ProtocolMessageTypeRequest:
+		return decodeRequest(data)
+	case ProtocolMessageTypeResponse:
+		return DecodeResponse(data)
+	case ProtocolMessageTypeEvent:
+		return decodeEvent(data)
+	default:
+		return nil, fmt.Errorf("decode message: unknown message type %q", m.Type)
+	}
+}
+
+// decodeRequest decodes data into the concrete request type of its command.
+func decodeRequest(data []byte) (Message, error) {
+	var r Request
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("decode request: %w", err)
+	}
+
+	newRequest, ok := requestTypes[r.Command]
+	if !ok {
+		return &r, nil
+	}
+	req := newRequest()
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, fmt.Errorf("decode %q request: %w", r.Command, err)
+	}
+	return req, nil
+}
+
+// DecodeResponse decodes data into the concrete response type of the command the response belongs to,
+// such as *StackTraceResponse for the 'stackTrace' command.
+//
+// A response whose success is false is decoded into *ErrorResponse, and a response to an unknown command is decoded into *Response.
+func DecodeResponse(data []byte) (Message, error) {
+	var r Response
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	var resp Message
+	switch newResponse, ok := responseTypes[r.Command]; {
+	case !r.Success:
+		resp = new(ErrorResponse)
+	case ok:
+		resp = newResponse()
+	default:
+		return &r, nil
+	}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, fmt.Errorf("decode %q response: %w", r.Command, err)
+	}
+	return resp, nil
+}
+
+// decodeEvent decodes data into the concrete event type.
+func decodeEvent(data []byte) (Message, error) {
+	var e Event
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("decode event: %w", err)
+	}
+
+	newEvent, ok := eventTypes[e.Event]
+	if !ok {
+		return &e, nil
+	}
+	event := newEvent()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("decode %q event: %w", e.Event, err)
+	}
+	return event, nil
+}
+
+// MessageHeader is the properties identifying a protocol message.
+type MessageHeader struct {
+	ProtocolMessage
+
+	// Command is the command of a request or a response.
+	Command string `json:"command"`
+
+	// Event is the type of an event.
+	Event string `json:"event"`
+
+	// RequestSeq is the sequence number of the request of a response.
+	RequestSeq int `json:"request_seq"`
+}
+
+// DecodeMessageHeader decodes the properties identifying the message in data and ignores the others.
+//
+// Unlike DecodeMessage, it decodes the properties found before the first syntax error of data,
+// and skips the properties whose value has the wrong type, so it reads the head of a message
+// which was truncated, such as the head of a transport.MessageTooLargeError, or whose content is invalid.
+// It returns an error if the type of the message is not found.
+func DecodeMessageHeader(data []byte) (*MessageHeader, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("decode message header: not a JSON object")
+	}
+
+	h := new(MessageHeader)
+	fields := map[string]interface{}{
+		"seq":         &h.Seq,
+		"type":        &h.Type,
+		"command":     &h.Command,
+		"event":       &h.Event,
+		"request_seq": &h.RequestSeq,
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			break
+		}
+		if name, ok := tok.(string); ok && fields[name] != nil {
+			_ = json.Unmarshal(value, fields[name])
+		}
+	}
+	if h.Type == "" {
+		return nil, errors.New("decode message header: missing type")
+	}
+	return h, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"testing"
+)
+
+func TestDecodeMessageHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want *MessageHeader
+	}{
+		{
+			name: "request",
+			data: `{"seq":3,"type":"request","command":"evaluate","arguments":{"expression":"x"}}`,
+			want: &MessageHeader{ProtocolMessage: ProtocolMessage{Seq: 3, Type: ProtocolMessageTypeRequest}, Command: "evaluate"},
+		},
+		{
+			name: "truncated request",
+			data: `{"seq":3,"type":"request","command":"evaluate","arguments":{"expression":"xxxx`,
+			want: &MessageHeader{ProtocolMessage: ProtocolMessage{Seq: 3, Type: ProtocolMessageTypeRequest}, Command: "evaluate"},
+		},
+		{
+			name: "truncated response",
+			data: `{"type":"response","request_seq":5,"success":true,"body":{"result":"xxxx`,
+			want: &MessageHeader{ProtocolMessage: ProtocolMessage{Type: ProtocolMessageTypeResponse}, RequestSeq: 5},
+		},
+		{
+			name: "event",
+			data: `{"seq":9,"type":"event","event":"output","body":{"output":"x"}}`,
+			want: &MessageHeader{ProtocolMessage: ProtocolMessage{Seq: 9, Type: ProtocolMessageTypeEvent}, Event: "output"},
+		},
+		{
+			name: "invalid property",
+			data: `{"seq":"3","type":"request","command":"next"}`,
+			want: &MessageHeader{ProtocolMessage: ProtocolMessage{Type: ProtocolMessageTypeRequest}, Command: "next"},
+		},
+		{
+			name: "type after the head",
+			data: `{"command":"evaluate","arguments":{"expression":"xxxx`,
+		},
+		{
+			name: "not an object",
+			data: `["request"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeMessageHeader([]byte(tt.data))
+			switch {
+			case tt.want == nil:
+				if err == nil {
+					t.Errorf("got %+v, want an error", got)
+				}
+			case err != nil:
+				t.Fatal(err)
+			case *got != *tt.want:
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
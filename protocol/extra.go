@@ -0,0 +1,102 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// MarshalJSON implements json.Marshaler.
+//
+// The attributes in Extra are encoded along with the ones defined by the protocol.
+func (m Module) MarshalJSON() ([]byte, error) {
+	type module Module
+	return marshalExtra(module(m), m.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// The attributes which are not defined by the protocol are kept in Extra.
+func (m *Module) UnmarshalJSON(data []byte) error {
+	type module Module
+	extra, err := unmarshalExtra(data, (*module)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// marshalExtra returns the JSON encoding of v with the extra attributes.
+// The attributes of v take precedence over the extra ones of the same name.
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	for name, value := range extra {
+		if _, ok := attrs[name]; !ok {
+			attrs[name] = value
+		}
+	}
+	return json.Marshal(attrs)
+}
+
+// unmarshalExtra decodes data into the struct pointed to by v and returns the attributes v has no field for.
+func unmarshalExtra(data []byte, v interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	for name := range jsonFields(reflect.TypeOf(v).Elem()) {
+		delete(attrs, name)
+	}
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	return attrs, nil
+}
+
+// fieldsCache caches the result of jsonFields keyed by the struct type.
+var fieldsCache sync.Map // map[reflect.Type]map[string]bool
+
+// jsonFields returns the set of JSON names of the fields of the struct type t.
+func jsonFields(t reflect.Type) map[string]bool {
+	if fields, ok := fieldsCache.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		switch {
+		case name == "-":
+			continue
+		case f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct:
+			for name := range jsonFields(f.Type) {
+				fields[name] = true
+			}
+			continue
+		case name == "":
+			name = f.Name
+		}
+		fields[name] = true
+	}
+	fieldsCache.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,174 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Message is a protocol message: a request, a response or an event.
+//
+// All the generated message types implement Message through the embedded ProtocolMessage.
+type Message interface {
+	// GetSeq returns the sequence number of the message.
+	GetSeq() int
+
+	// SetSeq sets the sequence number of the message.
+	SetSeq(seq int)
+
+	// GetType returns the message type, that is 'request', 'response' or 'event'.
+	GetType() ProtocolMessageType
+}
+
+// RequestMessage is a request message, implemented by Request and all the types embedding it.
+type RequestMessage interface {
+	Message
+
+	// GetCommand returns the command to execute.
+	GetCommand() string
+
+	// GetArguments returns the arguments for the command.
+	GetArguments() interface{}
+}
+
+// ResponseMessage is a response message, implemented by Response and all the types embedding it.
+type ResponseMessage interface {
+	Message
+
+	// GetRequestSeq returns the sequence number of the corresponding request.
+	GetRequestSeq() int
+
+	// GetCommand returns the command requested.
+	GetCommand() string
+
+	// GetSuccess returns the outcome of the request.
+	GetSuccess() bool
+
+	// GetMessage returns the raw error in short form if the request failed.
+	GetMessage() string
+
+	// GetBody returns the result of the request or the error details.
+	GetBody() interface{}
+}
+
+// EventMessage is an event message, implemented by Event and all the types embedding it.
+type EventMessage interface {
+	Message
+
+	// GetEvent returns the type of event.
+	GetEvent() string
+
+	// GetBody returns the event-specific information.
+	GetBody() interface{}
+}
+
+// GetSeq implements Message.
+func (m *ProtocolMessage) GetSeq() int { return m.Seq }
+
+// SetSeq implements Message.
+func (m *ProtocolMessage) SetSeq(seq int) { m.Seq = seq }
+
+// GetType implements Message.
+func (m *ProtocolMessage) GetType() ProtocolMessageType { return m.Type }
+
+// GetCommand implements RequestMessage.
+func (m *Request) GetCommand() string { return m.Command }
+
+// GetArguments implements RequestMessage.
+func (m *Request) GetArguments() interface{} { return m.Arguments }
+
+// GetRequestSeq implements ResponseMessage.
+func (m *Response) GetRequestSeq() int { return m.RequestSeq }
+
+// GetCommand implements ResponseMessage.
+func (m *Response) GetCommand() string { return m.Command }
+
+// GetSuccess implements ResponseMessage.
+func (m *Response) GetSuccess() bool { return m.Success }
+
+// GetMessage implements ResponseMessage.
+func (m *Response) GetMessage() string { return string(m.Message) }
+
+// GetBody implements ResponseMessage.
+func (m *Response) GetBody() interface{} { return m.Body }
+
+// GetEvent implements EventMessage.
+func (m *Event) GetEvent() string { return m.Event }
+
+// GetBody implements EventMessage.
+func (m *Event) GetBody() interface{} { return m.Body }
+
+// SetDefaults sets the properties of msg implied by its concrete type which are not set yet:
+// the message type, and the command of a request or a response or the event type of an event.
+// For example, the type of a *StoppedEvent is set to 'event' and its event to 'stopped'.
+func SetDefaults(msg Message) {
+	t := reflect.TypeOf(msg)
+	switch m := msg.(type) {
+	case interface{ request() *Request }:
+		r := m.request()
+		if r.Type == "" {
+			r.Type = ProtocolMessageTypeRequest
+		}
+		if r.Command == "" {
+			r.Command = messageNames().requests[t]
+		}
+	case interface{ response() *Response }:
+		r := m.response()
+		if r.Type == "" {
+			r.Type = ProtocolMessageTypeResponse
+		}
+		if r.Command == "" {
+			r.Command = messageNames().responses[t]
+		}
+	case interface{ event() *Event }:
+		e := m.event()
+		if e.Type == "" {
+			e.Type = ProtocolMessageTypeEvent
+		}
+		if e.Event == "" {
+			e.Event = messageNames().events[t]
+		}
+	}
+}
+
+func (m *Request) request() *Request { return m }
+
+func (m *Response) response() *Response { return m }
+
+func (m *Event) event() *Event { return m }
+
+// names maps the concrete message types to their command or event.
+type names struct {
+	requests  map[reflect.Type]string
+	responses map[reflect.Type]string
+	events    map[reflect.Type]string
+}
+
+var (
+	namesOnce   sync.Once
+	namesByType names
+)
+
+// messageNames returns the commands and events of the concrete message types from the tables used by DecodeMessage.
+func messageNames() *names {
+	namesOnce.Do(func() {
+		namesByType = names{
+			requests:  make(map[reflect.Type]string, len(requestTypes)),
+			responses: make(map[reflect.Type]string, len(responseTypes)),
+			events:    make(map[reflect.Type]string, len(eventTypes)),
+		}
+		for command, newRequest := range requestTypes {
+			namesByType.requests[reflect.TypeOf(newRequest())] = command
+		}
+		for command, newResponse := range responseTypes {
+			namesByType.responses[reflect.TypeOf(newResponse())] = command
+		}
+		for event, newEvent := range eventTypes {
+			namesByType.events[reflect.TypeOf(newEvent())] = event
+		}
+	})
+	return &namesByType
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// MessageWriter writes an encoded message, such as the Content-Length framed writer of the transport package.
+type MessageWriter interface {
+	WriteMessage(data []byte) error
+}
+
+// SeqWriter writes the messages sent by one side of a session, stamped with consecutive sequence numbers starting at 1.
+//
+// SeqWriter is safe for concurrent use. The sequence number of a message is allocated when it is written,
+// so the messages are written in the order of their sequence numbers whichever goroutine sends them.
+type SeqWriter struct {
+	mu  sync.Mutex
+	w   MessageWriter
+	seq int
+}
+
+// NewSeqWriter returns a new SeqWriter writing to w.
+func NewSeqWriter(w MessageWriter) *SeqWriter {
+	return &SeqWriter{
+		w: w,
+	}
+}
+
+// Write sets the defaults of msg by SetDefaults, stamps it with the next sequence number and writes it.
+func (w *SeqWriter) Write(msg Message) error {
+	return w.WriteFunc(msg, nil)
+}
+
+// WriteFunc is like Write but calls f with the sequence number of msg before writing it.
+// It lets the sender of a request register it before its response can be received.
+// If f returns an error, msg is not written and WriteFunc returns the error.
+// If WriteFunc fails after calling f, the request is not sent.
+func (w *SeqWriter) WriteFunc(msg Message, f func(seq int) error) error {
+	SetDefaults(msg)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	msg.SetSeq(w.seq + 1)
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("protocol: encode %s: %w", msg.GetType(), err)
+	}
+	if f != nil {
+		if err := f(w.seq + 1); err != nil {
+			return err
+		}
+	}
+	if err := w.w.WriteMessage(data); err != nil {
+		return err
+	}
+	w.seq++
+	return nil
+}
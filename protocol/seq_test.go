@@ -0,0 +1,102 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// seqRecorder records the sequence numbers of the messages written, in order.
+type seqRecorder struct {
+	mu   sync.Mutex
+	seqs []int
+	err  error // returned by WriteMessage if not nil
+}
+
+func (r *seqRecorder) WriteMessage(data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	var m ProtocolMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	r.seqs = append(r.seqs, m.Seq)
+	return nil
+}
+
+func TestSeqWriterConcurrent(t *testing.T) {
+	const writers, messages = 8, 100
+	r := new(seqRecorder)
+	w := NewSeqWriter(r)
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < messages; j++ {
+				var err error
+				if j%2 == 0 {
+					err = w.Write(&OutputEvent{Body: &OutputEventBody{Output: "x"}})
+				} else {
+					err = w.WriteFunc(&NextRequest{Arguments: &NextArguments{ThreadId: i}}, func(seq int) error { return nil })
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(r.seqs) != writers*messages {
+		t.Fatalf("%d messages written, want %d", len(r.seqs), writers*messages)
+	}
+	// The messages are written in the order of their sequence numbers, which are consecutive.
+	for i, seq := range r.seqs {
+		if seq != i+1 {
+			t.Fatalf("message %d written with seq %d, want %d", i, seq, i+1)
+		}
+	}
+}
+
+func TestSeqWriterFuncError(t *testing.T) {
+	r := new(seqRecorder)
+	w := NewSeqWriter(r)
+	errAbort := errors.New("abort")
+
+	var called int
+	err := w.WriteFunc(new(ThreadsRequest), func(seq int) error {
+		called = seq
+		return errAbort
+	})
+	if err != errAbort {
+		t.Errorf("got %v, want the error of f", err)
+	}
+	if called != 1 || len(r.seqs) != 0 {
+		t.Errorf("f called with %d and %d messages written, want 1 and none", called, len(r.seqs))
+	}
+
+	// The sequence number of the message not written is reused.
+	r.err = errors.New("closed")
+	if err := w.Write(new(ThreadsRequest)); err != r.err {
+		t.Errorf("got %v, want the error of the writer", err)
+	}
+	r.err = nil
+	msg := new(ThreadsRequest)
+	if err := w.Write(msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Seq != 1 || len(r.seqs) != 1 || r.seqs[0] != 1 {
+		t.Errorf("wrote %v with seq %d, want the first message with seq 1", r.seqs, msg.Seq)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// maxReference is the largest value of a reference allowed by the protocol,
+// which is a 32 bit signed integer in the implementations.
+const maxReference = 1<<31 - 1
+
+// references is the set of properties holding a reference restricted to 0..maxReference.
+var references = map[string]bool{
+	"sourceReference":    true,
+	"variablesReference": true,
+}
+
+// ValidationError is a violation of the protocol by a message.
+type ValidationError struct {
+	// Path is the JSON path of the invalid value in the message, such as "$.arguments.source.sourceReference".
+	Path string
+
+	// Reason describes the violation.
+	Reason string
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+// ValidationErrors is the list of violations found by Validate.
+type ValidationErrors []*ValidationError
+
+// Error implements error.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "invalid message: " + strings.Join(msgs, "; ")
+}
+
+// closedEnum is implemented by the generated string types whose values are restricted to the ones they define.
+type closedEnum interface {
+	valid() bool
+}
+
+// Validate checks the decoded message msg against the constraints of the protocol:
+//
+//   - the required properties are present,
+//   - the values of the closed enums are the defined ones,
+//   - the references are in the range of 0 to 2^31-1,
+//   - the type, command and event match the concrete type of msg,
+//   - request_seq of a response is not zero.
+//
+// The values of scalar types are always present after decoding, so only the required properties of
+// object, array and raw types are checked.
+//
+// Validate returns ValidationErrors listing all the violations found, or nil if msg is valid.
+func Validate(msg Message) error {
+	v := new(validator)
+	v.message(msg)
+	v.value("$", reflect.ValueOf(msg))
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// validator collects the violations found in a message.
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// message checks the properties identifying the kind of msg.
+func (v *validator) message(msg Message) {
+	t := reflect.TypeOf(msg)
+	switch m := msg.(type) {
+	case RequestMessage:
+		v.kind(msg, ProtocolMessageTypeRequest)
+		if command, ok := messageNames().requests[t]; ok && m.GetCommand() != command {
+			v.errorf("$.command", "must be %q for %s", command, t)
+		}
+	case ResponseMessage:
+		v.kind(msg, ProtocolMessageTypeResponse)
+		if m.GetRequestSeq() == 0 {
+			v.errorf("$.request_seq", "must not be zero")
+		}
+		if command, ok := messageNames().responses[t]; ok && m.GetCommand() != command {
+			v.errorf("$.command", "must be %q for %s", command, t)
+		}
+	case EventMessage:
+		v.kind(msg, ProtocolMessageTypeEvent)
+		if event, ok := messageNames().events[t]; ok && m.GetEvent() != event {
+			v.errorf("$.event", "must be %q for %s", event, t)
+		}
+	}
+}
+
+func (v *validator) kind(msg Message, typ ProtocolMessageType) {
+	if msg.GetType() != typ {
+		v.errorf("$.type", "must be %q for %s", typ, reflect.TypeOf(msg))
+	}
+}
+
+// value checks the value rv found at path and the values it contains.
+func (v *validator) value(path string, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !rv.IsNil() {
+			v.value(path, rv.Elem())
+		}
+	case reflect.Struct:
+		for _, f := range validationFields(rv.Type()) {
+			v.field(path+"."+f.name, f, rv.FieldByIndex(f.index))
+		}
+	case reflect.Slice:
+		if rv.Type() == rawMessageType {
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			v.value(fmt.Sprintf("%s[%d]", path, i), rv.Index(i))
+		}
+	}
+}
+
+// field checks the value rv of the struct field f found at path.
+func (v *validator) field(path string, f *validationField, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		if rv.IsNil() {
+			if f.required {
+				v.errorf(path, "missing required property")
+			}
+			return
+		}
+	case reflect.String:
+		if rv.Len() == 0 && !f.required {
+			return
+		}
+		if e, ok := rv.Interface().(closedEnum); ok && !e.valid() {
+			v.errorf(path, "unknown value %q", rv.String())
+		}
+	case reflect.Int:
+		if references[f.name] && (rv.Int() < 0 || rv.Int() > maxReference) {
+			v.errorf(path, "%d is out of range 0 to %d", rv.Int(), maxReference)
+		}
+	}
+	v.value(path, rv)
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// validationField is a field of a struct type as seen by encoding/json.
+type validationField struct {
+	name     string
+	index    []int
+	required bool
+}
+
+// validationFieldsCache caches the result of validationFields keyed by the struct type.
+var validationFieldsCache sync.Map // map[reflect.Type][]*validationField
+
+// validationFields returns the JSON encoded fields of the struct type t.
+// The fields of the embedded structs are included unless shadowed by a field of the same JSON name.
+func validationFields(t reflect.Type) []*validationField {
+	if fields, ok := validationFieldsCache.Load(t); ok {
+		return fields.([]*validationField)
+	}
+
+	var (
+		fields   []*validationField
+		embedded []*validationField
+		seen     = make(map[string]bool)
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		switch name := tag[0]; {
+		case name == "-" || f.PkgPath != "":
+			continue
+		case f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct:
+			for _, ef := range validationFields(f.Type) {
+				embedded = append(embedded, &validationField{
+					name:     ef.name,
+					index:    append([]int{i}, ef.index...),
+					required: ef.required,
+				})
+			}
+		default:
+			if name == "" {
+				name = f.Name
+			}
+			seen[name] = true
+			fields = append(fields, &validationField{
+				name:     name,
+				index:    []int{i},
+				required: !contains(tag[1:], "omitempty"),
+			})
+		}
+	}
+	for _, f := range embedded {
+		if !seen[f.name] {
+			fields = append(fields, f)
+		}
+	}
+	validationFieldsCache.Store(t, fields)
+	return fields
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidationMode is the way Validator handles the invalid messages.
+type ValidationMode int
+
+const (
+	// ValidateStrict rejects the invalid messages.
+	ValidateStrict ValidationMode = iota
+
+	// ValidateLog logs the invalid messages and lets them through.
+	ValidateLog
+)
+
+// Validator validates the messages sent and received by a session.
+//
+// A nil *Validator accepts all the messages, so validation is opt-in.
+type Validator struct {
+	// Mode is the way the invalid messages are handled.
+	Mode ValidationMode
+
+	// Logger logs the invalid messages in ValidateLog mode.
+	// If nil, the standard logger is used.
+	Logger *log.Logger
+}
+
+// Check validates msg with Validate.
+//
+// In ValidateStrict mode it returns the violations. In ValidateLog mode it logs them and returns nil.
+func (v *Validator) Check(msg Message) error {
+	if v == nil {
+		return nil
+	}
+	err := Validate(msg)
+	if err == nil || v.Mode == ValidateStrict {
+		return err
+	}
+
+	logf := log.Printf
+	if v.Logger != nil {
+		logf = v.Logger.Printf
+	}
+	logf("dap: %v", err)
+	return nil
+}
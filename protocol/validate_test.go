@@ -0,0 +1,173 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   Message
+		data  string
+		paths []string
+	}{
+		{
+			name: "valid request",
+			msg:  new(NextRequest),
+			data: `{"seq":1,"type":"request","command":"next","arguments":{"threadId":1,"granularity":"line"}}`,
+		},
+		{
+			name:  "missing arguments",
+			msg:   new(NextRequest),
+			data:  `{"seq":1,"type":"request","command":"next"}`,
+			paths: []string{"$.arguments"},
+		},
+		{
+			name:  "missing nested property",
+			msg:   new(SetBreakpointsRequest),
+			data:  `{"seq":1,"type":"request","command":"setBreakpoints","arguments":{"lines":[3]}}`,
+			paths: []string{"$.arguments.source"},
+		},
+		{
+			name:  "missing body property",
+			msg:   new(ThreadsResponse),
+			data:  `{"seq":2,"type":"response","request_seq":1,"command":"threads","success":true,"body":{}}`,
+			paths: []string{"$.body.threads"},
+		},
+		{
+			name:  "closed enum",
+			msg:   new(NextRequest),
+			data:  `{"seq":1,"type":"request","command":"next","arguments":{"threadId":1,"granularity":"word"}}`,
+			paths: []string{"$.arguments.granularity"},
+		},
+		{
+			name:  "closed enum in array",
+			msg:   new(StackTraceResponse),
+			data:  `{"seq":2,"type":"response","request_seq":1,"command":"stackTrace","success":true,"body":{"stackFrames":[{"id":1,"name":"main","line":1,"column":1},{"id":2,"name":"init","line":1,"column":1,"presentationHint":"hidden"}]}}`,
+			paths: []string{"$.body.stackFrames[1].presentationHint"},
+		},
+		{
+			name:  "sourceReference too large",
+			msg:   new(SourceRequest),
+			data:  `{"seq":1,"type":"request","command":"source","arguments":{"source":{"sourceReference":2147483648},"sourceReference":1}}`,
+			paths: []string{"$.arguments.source.sourceReference"},
+		},
+		{
+			name: "sourceReference max",
+			msg:  new(SourceRequest),
+			data: `{"seq":1,"type":"request","command":"source","arguments":{"source":{"sourceReference":2147483647},"sourceReference":2147483647}}`,
+		},
+		{
+			name:  "negative variablesReference",
+			msg:   new(VariablesRequest),
+			data:  `{"seq":1,"type":"request","command":"variables","arguments":{"variablesReference":-1}}`,
+			paths: []string{"$.arguments.variablesReference"},
+		},
+		{
+			name:  "wrong type",
+			msg:   new(NextRequest),
+			data:  `{"seq":1,"type":"event","command":"next","arguments":{"threadId":1}}`,
+			paths: []string{"$.type"},
+		},
+		{
+			name:  "wrong command",
+			msg:   new(NextRequest),
+			data:  `{"seq":1,"type":"request","command":"stepIn","arguments":{"threadId":1}}`,
+			paths: []string{"$.command"},
+		},
+		{
+			name:  "wrong response command",
+			msg:   new(NextResponse),
+			data:  `{"seq":2,"type":"response","request_seq":1,"command":"stepIn","success":true}`,
+			paths: []string{"$.command"},
+		},
+		{
+			name:  "wrong event",
+			msg:   new(StoppedEvent),
+			data:  `{"seq":3,"type":"event","event":"continued","body":{"reason":"step"}}`,
+			paths: []string{"$.event"},
+		},
+		{
+			name:  "zero request_seq",
+			msg:   new(NextResponse),
+			data:  `{"seq":2,"type":"response","request_seq":0,"command":"next","success":true}`,
+			paths: []string{"$.request_seq"},
+		},
+		{
+			name:  "several violations",
+			msg:   new(SetBreakpointsResponse),
+			data:  `{"seq":2,"type":"request","request_seq":0,"command":"setBreakpoints","success":true}`,
+			paths: []string{"$.type", "$.request_seq", "$.body"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.data), tt.msg); err != nil {
+				t.Fatal(err)
+			}
+			err := Validate(tt.msg)
+			var errs ValidationErrors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("got %T, want ValidationErrors", err)
+			}
+			var paths []string
+			for _, e := range errs {
+				paths = append(paths, e.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("got violations at %v (%v), want %v", paths, err, tt.paths)
+			}
+		})
+	}
+}
+
+func TestValidatorCheck(t *testing.T) {
+	invalid := &NextRequest{
+		Request: Request{ProtocolMessage: ProtocolMessage{Seq: 1, Type: ProtocolMessageTypeRequest}, Command: "next"},
+	}
+
+	var v *Validator
+	if err := v.Check(invalid); err != nil {
+		t.Errorf("nil Validator: got %v, want nil", err)
+	}
+
+	var buf bytes.Buffer
+	v = &Validator{Mode: ValidateStrict, Logger: log.New(&buf, "", 0)}
+	err := v.Check(invalid)
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "$.arguments" {
+		t.Errorf("strict mode: got %v, want the missing $.arguments", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("strict mode logged %q", buf.String())
+	}
+
+	v.Mode = ValidateLog
+	if err := v.Check(invalid); err != nil {
+		t.Errorf("log mode: got %v, want nil", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "$.arguments: missing required property") {
+		t.Errorf("log mode logged %q, want the missing $.arguments", got)
+	}
+
+	buf.Reset()
+	valid := &NextRequest{
+		Request:   Request{ProtocolMessage: ProtocolMessage{Seq: 1, Type: ProtocolMessageTypeRequest}, Command: "next"},
+		Arguments: &NextArguments{ThreadId: 1},
+	}
+	if err := v.Check(valid); err != nil || buf.Len() != 0 {
+		t.Errorf("log mode: got %v and logged %q for a valid message", err, buf.String())
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protocol
+
+// Version is the revision of the Debug Adapter Protocol specification
+// which api/debugAdapterProtocol.json and the types generated from it follow.
+//
+// The schema is the debugProtocol.json of vscode-debugadapter-node, unmodified,
+// and 1.70.0 is the revision adding its latest additions (supportsANSIStyling).
+// Update it together with the schema.
+const Version = "1.70.0"
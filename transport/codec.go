@@ -0,0 +1,199 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package transport implements the base protocol of the Debug Adapter Protocol,
+// which frames each message with a Content-Length header,
+// and the streams of messages between a client and a debug adapter over stdio, sockets and in-memory pipes.
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxMessageSize is the maximum size of a message read by Reader unless Reader.MaxMessageSize is set.
+const DefaultMaxMessageSize = 64 << 20
+
+const headerContentLength = "Content-Length"
+
+// maxHeaderSize is the maximum size of the header part of a message, so that a peer
+// which never ends it cannot make Reader buffer without bound.
+const maxHeaderSize = 8 << 10
+
+// messageHeadSize is the size of the head of a message kept by MessageTooLargeError.
+const messageHeadSize = 4 << 10
+
+// ErrMessageTooLarge is reported by Reader.ReadMessage if the content length of a message exceeds the maximum message size.
+// The content of the message is discarded, so the next message can be read.
+// The error returned is a *MessageTooLargeError, for which errors.Is(err, ErrMessageTooLarge) is true.
+var ErrMessageTooLarge = errors.New("transport: message too large")
+
+// MessageTooLargeError is the error returned when reading a message exceeding the maximum message size.
+//
+// It keeps the head of the discarded message, which usually holds the attributes identifying it,
+// so that the receiver can reply to a request or fail the request of a response it could not read.
+type MessageTooLargeError struct {
+	// Size is the size of the message.
+	Size int
+
+	// Head is the first bytes of the message, up to 4 KiB.
+	Head []byte
+}
+
+// Error implements error.
+func (e *MessageTooLargeError) Error() string {
+	return fmt.Sprintf("%v (%d bytes)", ErrMessageTooLarge, e.Size)
+}
+
+// Is reports whether target is ErrMessageTooLarge.
+func (e *MessageTooLargeError) Is(target error) bool {
+	return target == ErrMessageTooLarge
+}
+
+// Reader reads Content-Length framed messages from the underlying reader.
+type Reader struct {
+	// MaxMessageSize is the maximum content length of a message.
+	// If zero, DefaultMaxMessageSize is used.
+	MaxMessageSize int
+
+	r *bufio.Reader
+}
+
+// NewReader returns a new Reader reading from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// ReadMessage reads the next message and returns its content.
+//
+// Headers other than Content-Length are ignored.
+func (r *Reader) ReadMessage() ([]byte, error) {
+	length, err := r.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	max := r.MaxMessageSize
+	if max <= 0 {
+		max = DefaultMaxMessageSize
+	}
+	if length > max {
+		n := length
+		if n > messageHeadSize {
+			n = messageHeadSize
+		}
+		head := make([]byte, n)
+		if _, err := io.ReadFull(r.r, head); err != nil {
+			return nil, fmt.Errorf("transport: discard message: %w", err)
+		}
+		if _, err := io.CopyN(ioutil.Discard, r.r, int64(length-len(head))); err != nil {
+			return nil, fmt.Errorf("transport: discard message: %w", err)
+		}
+		return nil, &MessageTooLargeError{Size: length, Head: head}
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, fmt.Errorf("transport: read message: %w", err)
+	}
+	return data, nil
+}
+
+// readHeader reads the header part of a message and returns its content length.
+func (r *Reader) readHeader() (int, error) {
+	length := -1
+	size := 0
+	for first := true; ; first = false {
+		line, err := r.readLine(maxHeaderSize - size)
+		if err != nil {
+			if err == io.EOF {
+				if first && line == "" {
+					return 0, io.EOF
+				}
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, fmt.Errorf("transport: read header: %w", err)
+		}
+		size += len(line)
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			return 0, fmt.Errorf("transport: invalid header line %q", line)
+		}
+		name, value := strings.TrimSpace(line[:colon]), strings.TrimSpace(line[colon+1:])
+		if !strings.EqualFold(name, headerContentLength) {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("transport: invalid %s %q", headerContentLength, value)
+		}
+		length = n
+	}
+
+	if length < 0 {
+		return 0, fmt.Errorf("transport: missing %s header", headerContentLength)
+	}
+	return length, nil
+}
+
+// readLine reads a line of at most max bytes including its line feed.
+func (r *Reader) readLine(max int) (string, error) {
+	var line []byte
+	for {
+		b, err := r.r.ReadSlice('\n')
+		if len(line)+len(b) > max {
+			return "", errors.New("header too large")
+		}
+		line = append(line, b...)
+		if err != bufio.ErrBufferFull {
+			return string(line), err
+		}
+	}
+}
+
+// Writer writes Content-Length framed messages to the underlying writer.
+//
+// It is safe to call WriteMessage from multiple goroutines; each message is written with a single call
+// to the underlying writer, so messages are never interleaved.
+type Writer struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+// NewWriter returns a new Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: w,
+	}
+}
+
+// WriteMessage writes data framed with a Content-Length header.
+func (w *Writer) WriteMessage(data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Reset()
+	fmt.Fprintf(&w.buf, "%s: %d\r\n\r\n", headerContentLength, len(data))
+	w.buf.Write(data)
+	if _, err := w.w.Write(w.buf.Bytes()); err != nil {
+		return fmt.Errorf("transport: write message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReaderHeaders(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "content-length",
+			input: "Content-Length: 2\r\n\r\n{}",
+			want:  "{}",
+		},
+		{
+			name:  "lower case",
+			input: "content-length: 2\r\n\r\n{}",
+			want:  "{}",
+		},
+		{
+			name:  "mixed case",
+			input: "CONTENT-length:2\r\n\r\n{}",
+			want:  "{}",
+		},
+		{
+			name:  "extra headers",
+			input: "Content-Type: application/vscode-jsonrpc; charset=utf-8\r\nContent-Length: 2\r\nX-Trace: 1\r\n\r\n{}",
+			want:  "{}",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			got, err := r.ReadMessage()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if _, err := r.ReadMessage(); err != io.EOF {
+				t.Errorf("got %v at the end of the input, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestReaderInvalidHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "missing content-length",
+			input: "Content-Type: application/json\r\n\r\n{}",
+		},
+		{
+			name:  "invalid content-length",
+			input: "Content-Length: two\r\n\r\n{}",
+		},
+		{
+			name:  "negative content-length",
+			input: "Content-Length: -2\r\n\r\n{}",
+		},
+		{
+			name:  "no colon",
+			input: "Content-Length 2\r\n\r\n{}",
+		},
+		{
+			name:  "truncated",
+			input: "Content-Length: 2\r\n",
+		},
+		{
+			name:  "header line too large",
+			input: "X-Padding: " + strings.Repeat("x", maxHeaderSize) + "\r\nContent-Length: 2\r\n\r\n{}",
+		},
+		{
+			name:  "header too large",
+			input: strings.Repeat("X-Padding: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx\r\n", maxHeaderSize/50+1) + "Content-Length: 2\r\n\r\n{}",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.input))
+			if data, err := r.ReadMessage(); err == nil || err == io.EOF {
+				t.Errorf("got %q, %v, want an error", data, err)
+			}
+		})
+	}
+}
+
+func TestReaderMaxMessageSize(t *testing.T) {
+	large := `{"seq":7,"type":"request","command":"evaluate","arguments":{"expression":"` + strings.Repeat("x", 10000) + `"}}`
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, msg := range []string{large, `{"seq":8}`} {
+		if err := w.WriteMessage([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := NewReader(&buf)
+	r.MaxMessageSize = 1000
+	_, err := r.ReadMessage()
+	if !errors.Is(err, ErrMessageTooLarge) {
+		t.Fatalf("got %v, want ErrMessageTooLarge", err)
+	}
+	var tooLarge *MessageTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Fatalf("got %T, want *MessageTooLargeError", err)
+	}
+	if tooLarge.Size != len(large) {
+		t.Errorf("size = %d, want %d", tooLarge.Size, len(large))
+	}
+	if string(tooLarge.Head) != large[:messageHeadSize] {
+		t.Errorf("head = %q, want the first %d bytes of the message", tooLarge.Head, messageHeadSize)
+	}
+
+	// The message is discarded, so the next one is read.
+	got, err := r.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != `{"seq":8}` {
+		t.Errorf("got %q after the message too large, want the next message", got)
+	}
+}
+
+// recordingWriter records the data of each call to Write.
+type recordingWriter struct {
+	mu     sync.Mutex
+	writes [][]byte
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestWriterConcurrent(t *testing.T) {
+	const writers, messages = 8, 50
+	rw := new(recordingWriter)
+	w := NewWriter(rw)
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < messages; j++ {
+				msg := fmt.Sprintf(`{"writer":%d,"message":%d,"padding":%q}`, i, j, strings.Repeat("x", 100*j))
+				if err := w.WriteMessage([]byte(msg)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(rw.writes) != writers*messages {
+		t.Fatalf("%d writes, want one per message (%d)", len(rw.writes), writers*messages)
+	}
+	seen := make(map[string]bool)
+	for _, data := range rw.writes {
+		r := NewReader(bytes.NewReader(data))
+		msg, err := r.ReadMessage()
+		if err != nil {
+			t.Fatalf("write %q is not a framed message: %v", data, err)
+		}
+		if _, err := r.ReadMessage(); err != io.EOF {
+			t.Fatalf("write %q holds more than a message", data)
+		}
+		seen[string(msg)] = true
+	}
+	if len(seen) != writers*messages {
+		t.Errorf("%d distinct messages written, want %d", len(seen), writers*messages)
+	}
+}
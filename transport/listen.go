@@ -0,0 +1,68 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+)
+
+// ServeFunc serves the peer connected by s until it disconnects or ctx is done.
+type ServeFunc func(ctx context.Context, s Stream)
+
+// Serve accepts the connections on l and serves each of them by serve in its own goroutine,
+// which lets a debug adapter run in server mode serving independent sessions.
+//
+// Serve closes l and returns when ctx is done or accepting fails.
+// It cancels the context passed to serve and waits for the connections to be served before returning.
+// The streams are closed after serve returns.
+func Serve(ctx context.Context, l net.Listener, serve ServeFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("transport: accept: %w", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := NewStream(conn)
+			defer s.Close()
+			serve(ctx, s)
+		}()
+	}
+}
+
+// ListenAndServe listens on the network address, such as a TCP port ("tcp", ":4711")
+// or a Unix domain socket ("unix", "/tmp/dap.sock"), and serves the connections by serve with Serve.
+func ListenAndServe(ctx context.Context, network, address string, serve ServeFunc) error {
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return fmt.Errorf("transport: %w", err)
+	}
+	return Serve(ctx, l, serve)
+}
@@ -0,0 +1,22 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package transport
+
+import (
+	"os"
+)
+
+// newStdio returns the Stream reading from in and writing to out.
+// Closing the stream closes in.
+func newStdio(in, out *os.File) Stream {
+	return &stream{
+		Reader: NewReader(in),
+		Writer: NewWriter(out),
+		c:      in,
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package transport
+
+import (
+	"io"
+	"os"
+	"syscall"
+)
+
+// newStdio returns the Stream reading from in and writing to out.
+//
+// Closing a file in blocking mode does not unblock a pending read, so if in is a pipe or a socket,
+// the stream reads from a duplicate of in in non-blocking mode, which the runtime polls and
+// closing it makes the pending ReadMessage return. Closing the stream closes the duplicate and in.
+// The duplicate shares the mode of in, which must not be read directly afterwards.
+func newStdio(in, out *os.File) Stream {
+	s := &stream{
+		Reader: NewReader(in),
+		Writer: NewWriter(out),
+		c:      in,
+	}
+	if r := pollable(in); r != nil {
+		s.Reader = NewReader(r)
+		s.c = closers{r, in}
+	}
+	return s
+}
+
+// pollable returns a duplicate of the pipe or socket f in non-blocking mode,
+// or nil if f is another kind of file or cannot be duplicated.
+func pollable(f *os.File) *os.File {
+	fi, err := f.Stat()
+	if err != nil || fi.Mode()&(os.ModeNamedPipe|os.ModeSocket) == 0 {
+		return nil
+	}
+	syscall.ForkLock.RLock()
+	fd, err := syscall.Dup(int(f.Fd()))
+	if err == nil {
+		syscall.CloseOnExec(fd)
+	}
+	syscall.ForkLock.RUnlock()
+	if err != nil {
+		return nil
+	}
+	if err := syscall.SetNonblock(fd, true); err != nil {
+		syscall.Close(fd)
+		return nil
+	}
+	return os.NewFile(uintptr(fd), f.Name())
+}
+
+// closers closes all its closers and returns the first error.
+type closers []io.Closer
+
+func (cs closers) Close() error {
+	var err error
+	for _, c := range cs {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package transport
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestStdioCloseUnblocksRead(t *testing.T) {
+	// The pipe is in blocking mode, as the standard input of a process is.
+	var fds [2]int
+	if err := syscall.Pipe(fds[:]); err != nil {
+		t.Fatal(err)
+	}
+	r, w := os.NewFile(uintptr(fds[0]), "stdin"), os.NewFile(uintptr(fds[1]), "client")
+	defer w.Close()
+
+	s := newStdio(r, os.Stdout)
+	if _, err := w.Write([]byte("Content-Length: 2\r\n\r\n{}")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.ReadMessage()
+	if err != nil || string(data) != "{}" {
+		t.Fatalf("got %q, %v, want the message written", data, err)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := s.ReadMessage()
+		errc <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Error("ReadMessage succeeded after Close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ReadMessage still blocked after Close")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"io"
+	"net"
+	"os"
+)
+
+// Stream is a bidirectional stream of messages between a client and a debug adapter.
+type Stream interface {
+	// ReadMessage reads the next message and returns its content.
+	// It returns io.EOF when the stream is closed by the peer.
+	ReadMessage() ([]byte, error)
+
+	// WriteMessage writes the message data. It may be called from multiple goroutines.
+	WriteMessage(data []byte) error
+
+	// Close closes the stream, which makes the pending ReadMessage return.
+	Close() error
+}
+
+// stream is a Stream framing the messages with a Content-Length header.
+type stream struct {
+	*Reader
+	*Writer
+	c io.Closer
+}
+
+// NewStream returns a Stream reading and writing Content-Length framed messages over rw.
+// Closing the stream closes rw if it implements io.Closer.
+func NewStream(rw io.ReadWriter) Stream {
+	s := &stream{
+		Reader: NewReader(rw),
+		Writer: NewWriter(rw),
+	}
+	if c, ok := rw.(io.Closer); ok {
+		s.c = c
+	}
+	return s
+}
+
+// Close implements Stream.
+func (s *stream) Close() error {
+	if s.c == nil {
+		return nil
+	}
+	return s.c.Close()
+}
+
+// Stdio returns the Stream reading from os.Stdin and writing to os.Stdout,
+// which is how a debug adapter spawned by a client talks to it.
+// Closing the stream closes os.Stdin.
+//
+// On Unix, if the standard input is a pipe or a socket, as when the client spawns the debug adapter,
+// closing the stream also makes a pending ReadMessage return, so that ServeStream returns when its context is done.
+// Otherwise a pending ReadMessage only returns once the client closes its end of the standard input.
+func Stdio() Stream {
+	return newStdio(os.Stdin, os.Stdout)
+}
+
+// Pipe returns a pair of Streams connected in memory, such as a client and a debug adapter in tests.
+// The messages written to one stream are read from the other.
+func Pipe() (Stream, Stream) {
+	c1, c2 := net.Pipe()
+	return NewStream(c1), NewStream(c2)
+}
@@ -0,0 +1,465 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// websocketGUID is the GUID appended to the key of the opening handshake to compute the accept key, see RFC 6455.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// The opcodes of the WebSocket frames.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// The status codes of the close frames.
+const (
+	closeNormal        = 1000
+	closeProtocolError = 1002
+)
+
+// WebSocketHandler returns the http.Handler upgrading the requests to WebSocket connections
+// and serving each of them by serve, which lets browser-based clients talk to a debug adapter.
+//
+// Each message is sent in a WebSocket text message without the Content-Length header.
+// The stream is closed after serve returns.
+// The messages read are limited to DefaultMaxMessageSize; use UpgradeWebSocket in a handler to set another limit.
+func WebSocketHandler(serve ServeFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, err := UpgradeWebSocket(w, r)
+		if err != nil {
+			return
+		}
+		defer s.Close()
+		serve(r.Context(), s)
+	})
+}
+
+// UpgradeWebSocket performs the opening handshake of the WebSocket protocol for the request r
+// and returns the Stream over the WebSocket connection.
+//
+// If the handshake fails, UpgradeWebSocket replies to the request with an HTTP error.
+func UpgradeWebSocket(w http.ResponseWriter, r *http.Request) (*WebSocket, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	switch {
+	case r.Method != http.MethodGet:
+		http.Error(w, "websocket: method not allowed", http.StatusMethodNotAllowed)
+		return nil, errors.New("transport: websocket: method not allowed")
+	case !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket"):
+		http.Error(w, "websocket: not a websocket handshake", http.StatusBadRequest)
+		return nil, errors.New("transport: websocket: not a websocket handshake")
+	case r.Header.Get("Sec-WebSocket-Version") != "13":
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "websocket: unsupported version", http.StatusUpgradeRequired)
+		return nil, errors.New("transport: websocket: unsupported version")
+	case key == "":
+		http.Error(w, "websocket: missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, errors.New("transport: websocket: missing Sec-WebSocket-Key")
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket: connection cannot be upgraded", http.StatusInternalServerError)
+		return nil, errors.New("transport: websocket: connection cannot be upgraded")
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("transport: websocket: %w", err)
+	}
+	fmt.Fprintf(brw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", acceptKey(key))
+	if err := brw.Flush(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("transport: websocket: %w", err)
+	}
+	return newWebSocket(conn, brw.Reader, false), nil
+}
+
+// DialWebSocket connects to the WebSocket server at the URL of scheme "ws" or "wss"
+// and returns the Stream over the connection.
+func DialWebSocket(ctx context.Context, rawurl string) (*WebSocket, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, fmt.Errorf("transport: websocket: %w", err)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		switch u.Scheme {
+		case "ws":
+			host = net.JoinHostPort(u.Hostname(), "80")
+		case "wss":
+			host = net.JoinHostPort(u.Hostname(), "443")
+		}
+	}
+
+	var d net.Dialer
+	var conn net.Conn
+	switch u.Scheme {
+	case "ws":
+		conn, err = d.DialContext(ctx, "tcp", host)
+	case "wss":
+		conn, err = d.DialContext(ctx, "tcp", host)
+		if err == nil {
+			tc := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+			conn, err = tc, tc.Handshake()
+		}
+	default:
+		return nil, fmt.Errorf("transport: websocket: unsupported scheme %q", u.Scheme)
+	}
+	if err != nil {
+		if conn != nil {
+			conn.Close()
+		}
+		return nil, fmt.Errorf("transport: websocket: %w", err)
+	}
+
+	s, err := handshake(ctx, conn, u)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("transport: websocket: %w", err)
+	}
+	return s, nil
+}
+
+// handshake performs the opening handshake of the client over conn.
+func handshake(ctx context.Context, conn net.Conn, u *url.URL) (*WebSocket, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: u.Path, RawQuery: u.RawQuery},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Host:       u.Host,
+		Header: http.Header{
+			"Upgrade":               {"websocket"},
+			"Connection":            {"Upgrade"},
+			"Sec-WebSocket-Key":     {key},
+			"Sec-WebSocket-Version": {"13"},
+		},
+	}
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, fmt.Errorf("handshake failed: %s", resp.Status)
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		return nil, errors.New("handshake failed: invalid Sec-WebSocket-Accept")
+	}
+	return newWebSocket(conn, r, true), nil
+}
+
+// acceptKey returns the Sec-WebSocket-Accept value for the Sec-WebSocket-Key key.
+func acceptKey(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// headerContains reports whether the comma-separated list of the header name contains token,
+// compared case-insensitively.
+func headerContains(header http.Header, name, token string) bool {
+	for _, v := range header[http.CanonicalHeaderKey(name)] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WebSocket is a Stream sending each message in a WebSocket text message.
+type WebSocket struct {
+	// MaxMessageSize is the maximum size of a message read, reassembled from its fragments.
+	// If zero, DefaultMaxMessageSize is used.
+	MaxMessageSize int
+
+	conn   net.Conn
+	r      *bufio.Reader
+	client bool // masks the frames written as required from the clients
+
+	mu        sync.Mutex // guards writing the frames
+	closeSent sync.Once
+	closeOnce sync.Once
+}
+
+func newWebSocket(conn net.Conn, r *bufio.Reader, client bool) *WebSocket {
+	return &WebSocket{
+		conn:   conn,
+		r:      r,
+		client: client,
+	}
+}
+
+// ReadMessage implements Stream.
+//
+// The fragmented messages are reassembled and the ping frames are answered.
+// It returns io.EOF when the peer closes the connection, and a *MessageTooLargeError
+// if the message exceeds MaxMessageSize, in which case the message is discarded.
+//
+// As required by RFC 6455, the connection is failed if a client sends an unmasked frame,
+// a server sends a masked frame, or a frame has a 64-bit payload length with the most significant bit set.
+func (ws *WebSocket) ReadMessage() ([]byte, error) {
+	max := uint64(ws.MaxMessageSize)
+	if ws.MaxMessageSize <= 0 {
+		max = DefaultMaxMessageSize
+	}
+
+	var (
+		msg      []byte
+		started  bool
+		tooLarge *MessageTooLargeError
+		size     uint64
+	)
+	for {
+		fin, op, length, mask, err := ws.readHeader()
+		if err != nil {
+			return nil, err
+		}
+		if (mask != nil) == ws.client {
+			if ws.client {
+				return nil, ws.fail("masked frame from the server")
+			}
+			return nil, ws.fail("unmasked frame from the client")
+		}
+		if op < opClose && (tooLarge != nil || uint64(len(msg))+length > max) {
+			if tooLarge == nil {
+				tooLarge = &MessageTooLargeError{Head: msg}
+				if len(msg) > messageHeadSize {
+					tooLarge.Head = msg[:messageHeadSize]
+				}
+				size = uint64(len(msg))
+			}
+			n := uint64(messageHeadSize - len(tooLarge.Head))
+			if n > length {
+				n = length
+			}
+			head := make([]byte, n)
+			if _, err := io.ReadFull(ws.r, head); err != nil {
+				return nil, fmt.Errorf("transport: websocket: discard message: %w", err)
+			}
+			if mask != nil {
+				maskBytes(*mask, head)
+			}
+			tooLarge.Head = append(tooLarge.Head, head...)
+			if _, err := io.CopyN(ioutil.Discard, ws.r, int64(length-n)); err != nil {
+				return nil, fmt.Errorf("transport: websocket: discard message: %w", err)
+			}
+			if size += length; size > math.MaxInt32 {
+				size = math.MaxInt32
+			}
+			if fin {
+				tooLarge.Size = int(size)
+				return nil, tooLarge
+			}
+			continue
+		}
+
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(ws.r, payload); err != nil {
+			return nil, fmt.Errorf("transport: websocket: read frame: %w", err)
+		}
+		if mask != nil {
+			maskBytes(*mask, payload)
+		}
+
+		switch op {
+		case opPing:
+			if err := ws.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			ws.sendClose(payload)
+			return nil, io.EOF
+		case opText, opBinary:
+			if started {
+				return nil, errors.New("transport: websocket: unexpected data frame in fragmented message")
+			}
+			started = true
+		case opContinuation:
+			if !started {
+				return nil, errors.New("transport: websocket: unexpected continuation frame")
+			}
+		default:
+			return nil, fmt.Errorf("transport: websocket: unknown opcode %#x", op)
+		}
+
+		msg = append(msg, payload...)
+		if fin {
+			return msg, nil
+		}
+	}
+}
+
+// readHeader reads the header of the next frame and returns the length of its payload
+// and its masking key if masked.
+func (ws *WebSocket) readHeader() (fin bool, op byte, length uint64, mask *[4]byte, err error) {
+	var head [2]byte
+	if _, err := io.ReadFull(ws.r, head[:]); err != nil {
+		if err == io.EOF {
+			return false, 0, 0, nil, io.EOF
+		}
+		return false, 0, 0, nil, fmt.Errorf("transport: websocket: read frame: %w", err)
+	}
+	fin, op = head[0]&0x80 != 0, head[0]&0x0f
+
+	length = uint64(head[1] & 0x7f)
+	switch length {
+	case 126:
+		var b [2]byte
+		if _, err := io.ReadFull(ws.r, b[:]); err != nil {
+			return false, 0, 0, nil, fmt.Errorf("transport: websocket: read frame: %w", err)
+		}
+		length = uint64(binary.BigEndian.Uint16(b[:]))
+	case 127:
+		var b [8]byte
+		if _, err := io.ReadFull(ws.r, b[:]); err != nil {
+			return false, 0, 0, nil, fmt.Errorf("transport: websocket: read frame: %w", err)
+		}
+		length = binary.BigEndian.Uint64(b[:])
+		if length > math.MaxInt64 {
+			return false, 0, 0, nil, ws.fail("invalid payload length")
+		}
+	}
+	if op >= opClose && length > 125 {
+		return false, 0, 0, nil, ws.fail("control frame too large")
+	}
+
+	if head[1]&0x80 != 0 {
+		mask = new([4]byte)
+		if _, err := io.ReadFull(ws.r, mask[:]); err != nil {
+			return false, 0, 0, nil, fmt.Errorf("transport: websocket: read frame: %w", err)
+		}
+	}
+	return fin, op, length, mask, nil
+}
+
+// fail fails the connection after a violation of the protocol by the peer:
+// it sends the close frame with the protocol error status and returns the error describing the violation.
+func (ws *WebSocket) fail(reason string) error {
+	var status [2]byte
+	binary.BigEndian.PutUint16(status[:], closeProtocolError)
+	ws.sendClose(status[:])
+	return errors.New("transport: websocket: " + reason)
+}
+
+// WriteMessage implements Stream.
+func (ws *WebSocket) WriteMessage(data []byte) error {
+	return ws.writeFrame(opText, data)
+}
+
+// writeFrame writes a single frame of opcode op with payload.
+func (ws *WebSocket) writeFrame(op byte, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|op)
+
+	var maskBit byte
+	if ws.client {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, maskBit|byte(n))
+	case n <= 0xffff:
+		frame = append(frame, maskBit|126, byte(n>>8), byte(n))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = append(frame, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(frame[len(frame)-8:], uint64(n))
+	}
+
+	if ws.client {
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return fmt.Errorf("transport: websocket: %w", err)
+		}
+		frame = append(frame, mask[:]...)
+		start := len(frame)
+		frame = append(frame, payload...)
+		maskBytes(mask, frame[start:])
+	} else {
+		frame = append(frame, payload...)
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if _, err := ws.conn.Write(frame); err != nil {
+		return fmt.Errorf("transport: websocket: write message: %w", err)
+	}
+	return nil
+}
+
+// Close implements Stream. It sends the close frame before closing the connection.
+func (ws *WebSocket) Close() error {
+	var err error
+	ws.closeOnce.Do(func() {
+		var status [2]byte
+		binary.BigEndian.PutUint16(status[:], closeNormal)
+		ws.sendClose(status[:])
+		err = ws.conn.Close()
+	})
+	return err
+}
+
+// sendClose sends the close frame with payload unless it has been sent already.
+func (ws *WebSocket) sendClose(payload []byte) {
+	ws.closeSent.Do(func() {
+		ws.writeFrame(opClose, payload)
+	})
+}
+
+// maskBytes masks or unmasks b with the masking key mask.
+func maskBytes(mask [4]byte, b []byte) {
+	for i := range b {
+		b[i] ^= mask[i%4]
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 The go-language-server Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dialTestServer connects to the WebSocket server of h.
+func dialTestServer(t *testing.T, h http.Handler) (*WebSocket, func()) {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ws, err := DialWebSocket(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"))
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return ws, func() {
+		ws.Close()
+		srv.Close()
+	}
+}
+
+func TestWebSocketRoundTrip(t *testing.T) {
+	echo := WebSocketHandler(func(ctx context.Context, s Stream) {
+		for {
+			data, err := s.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := s.WriteMessage(data); err != nil {
+				return
+			}
+		}
+	})
+	ws, closeServer := dialTestServer(t, echo)
+	defer closeServer()
+
+	// The sizes cover the 7-bit, 16-bit and 64-bit payload lengths of the frames.
+	for _, size := range []int{1, 200, 70000} {
+		msg := bytes.Repeat([]byte("x"), size)
+		if err := ws.WriteMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ws.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, msg) {
+			t.Errorf("got %d bytes back, want %d", len(got), size)
+		}
+	}
+}
+
+func TestWebSocketMaxMessageSize(t *testing.T) {
+	results := make(chan error, 2)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := UpgradeWebSocket(w, r)
+		if err != nil {
+			results <- err
+			return
+		}
+		defer ws.Close()
+		ws.MaxMessageSize = 100
+		for i := 0; i < 2; i++ {
+			_, err := ws.ReadMessage()
+			results <- err
+		}
+	})
+	ws, closeServer := dialTestServer(t, h)
+	defer closeServer()
+
+	if err := ws.WriteMessage(bytes.Repeat([]byte("x"), 101)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.WriteMessage(bytes.Repeat([]byte("x"), 100)); err != nil {
+		t.Fatal(err)
+	}
+	err := <-results
+	var tooLarge *MessageTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("got %v for the message too large, want a *MessageTooLargeError", err)
+	} else if tooLarge.Size != 101 || !bytes.Equal(tooLarge.Head, bytes.Repeat([]byte("x"), 101)) {
+		t.Errorf("got a message of %d bytes with a head of %d bytes, want 101 bytes for both", tooLarge.Size, len(tooLarge.Head))
+	}
+	if err := <-results; err != nil {
+		t.Errorf("got %v for the message after the one too large", err)
+	}
+}
+
+func TestWebSocketUnmaskedClientFrame(t *testing.T) {
+	results := make(chan error, 1)
+	h := WebSocketHandler(func(ctx context.Context, s Stream) {
+		_, err := s.ReadMessage()
+		results <- err
+	})
+	ws, closeServer := dialTestServer(t, h)
+	defer closeServer()
+
+	// Write the frames unmasked as a server does.
+	ws.client = false
+	if err := ws.WriteMessage([]byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-results; err == nil {
+		t.Fatal("unmasked frame accepted by the server")
+	}
+	ws.client = true
+	if _, err := ws.ReadMessage(); err != io.EOF {
+		t.Errorf("got %v, want io.EOF after the close frame of the server", err)
+	}
+}
+
+func TestWebSocketInvalidPayloadLength(t *testing.T) {
+	results := make(chan error, 1)
+	h := WebSocketHandler(func(ctx context.Context, s Stream) {
+		_, err := s.ReadMessage()
+		results <- err
+	})
+	ws, closeServer := dialTestServer(t, h)
+	defer closeServer()
+
+	// A masked text frame whose 64-bit payload length has the most significant bit set,
+	// followed by its payload.
+	frame := []byte{0x81, 0x80 | 127, 0x80, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, '{', '}'}
+	if _, err := ws.conn.Write(frame); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-results:
+		var tooLarge *MessageTooLargeError
+		if err == nil || errors.As(err, &tooLarge) {
+			t.Fatalf("got %v, want the connection failed", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("invalid payload length not detected")
+	}
+	if _, err := ws.ReadMessage(); err != io.EOF {
+		t.Errorf("got %v, want io.EOF after the close frame of the server", err)
+	}
+}